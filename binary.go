@@ -0,0 +1,135 @@
+package matrix
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"math"
+)
+
+// The binary encoding of a matrix is laid out in little-endian order as
+//
+//	magic    [4]byte  "MTRX"
+//	version  uint16
+//	reserved uint16   always 0
+//	rows     uint64
+//	columns  uint64
+//	values   [rows*columns]float64, row-major
+//	checksum uint32   CRC-32 (IEEE) of all preceding bytes
+const (
+	binaryMagic      = "MTRX"
+	binaryVersion    = 1
+	binaryHeaderSize = 24
+	binaryTrailer    = 4
+)
+
+var (
+	// ErrBinaryMagic is returned when binary data does not start with the matrix magic number.
+	ErrBinaryMagic = errors.New("matrix: binary data has an invalid magic number")
+	// ErrBinaryVersion is returned when binary data was written by an unsupported format version.
+	ErrBinaryVersion = errors.New("matrix: binary data has an unsupported version")
+	// ErrBinaryLength is returned when binary data is truncated or longer than its shape requires.
+	ErrBinaryLength = errors.New("matrix: binary data length does not match its shape")
+	// ErrBinaryChecksum is returned when binary data fails its checksum.
+	ErrBinaryChecksum = errors.New("matrix: binary data checksum mismatch")
+)
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It returns ErrBinaryLength for a matrix with no columns and more than MaxEmptyRows rows.
+func (m Matrix) MarshalBinary() ([]byte, error) {
+	// Check if the decoder would accept the shape
+	if tooManyEmptyRows(m.rows, m.columns) {
+		return nil, ErrBinaryLength
+	}
+
+	data := make([]byte, 0, binaryHeaderSize+8*m.rows*m.columns+binaryTrailer)
+
+	// Write the header
+	data = append(data, binaryMagic...)
+	data = binary.LittleEndian.AppendUint16(data, binaryVersion)
+	data = binary.LittleEndian.AppendUint16(data, 0)
+	data = binary.LittleEndian.AppendUint64(data, uint64(m.rows))
+	data = binary.LittleEndian.AppendUint64(data, uint64(m.columns))
+
+	// Write the values row by row
+	for i := 0; i < m.rows; i++ {
+		for j := 0; j < m.columns; j++ {
+			data = binary.LittleEndian.AppendUint64(data, math.Float64bits(m.values[i][j]))
+		}
+	}
+
+	// Write the checksum of everything before it
+	return binary.LittleEndian.AppendUint32(data, crc32.ChecksumIEEE(data)), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// It returns an error for data that is truncated, corrupted or written by an unsupported version,
+// and ErrBinaryLength for a shape with no columns and more than MaxEmptyRows rows.
+func (m *Matrix) UnmarshalBinary(data []byte) error {
+	// Check the header
+	if len(data) < binaryHeaderSize+binaryTrailer {
+		return ErrBinaryLength
+	}
+	if string(data[:4]) != binaryMagic {
+		return ErrBinaryMagic
+	}
+	if version := binary.LittleEndian.Uint16(data[4:6]); version != binaryVersion {
+		return fmt.Errorf("%w: %d", ErrBinaryVersion, version)
+	}
+
+	// Check if the shape matches the length of the data without overflowing
+	rows := binary.LittleEndian.Uint64(data[8:16])
+	columns := binary.LittleEndian.Uint64(data[16:24])
+	count := uint64(len(data)-binaryHeaderSize-binaryTrailer) / 8
+	if uint64(len(data)-binaryHeaderSize-binaryTrailer)%8 != 0 {
+		return ErrBinaryLength
+	}
+	if rows > math.MaxInt32 || columns > math.MaxInt32 {
+		return ErrBinaryLength
+	}
+	if tooManyEmptyRows(int(rows), int(columns)) {
+		return ErrBinaryLength
+	}
+	if columns == 0 {
+		if count != 0 {
+			return ErrBinaryLength
+		}
+	} else if rows > count/columns || rows*columns != count {
+		return ErrBinaryLength
+	}
+
+	// Check the checksum
+	body := data[:len(data)-binaryTrailer]
+	if crc32.ChecksumIEEE(body) != binary.LittleEndian.Uint32(data[len(data)-binaryTrailer:]) {
+		return ErrBinaryChecksum
+	}
+
+	// Read the values row by row
+	result := Matrix{
+		rows:    int(rows),
+		columns: int(columns),
+		values:  make([][]float64, rows),
+	}
+	offset := binaryHeaderSize
+	for i := range result.values {
+		result.values[i] = make([]float64, columns)
+		for j := range result.values[i] {
+			result.values[i][j] = math.Float64frombits(binary.LittleEndian.Uint64(data[offset:]))
+			offset += 8
+		}
+	}
+
+	*m = result
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the binary encoding.
+func (m Matrix) GobEncode() ([]byte, error) {
+	return m.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the binary encoding.
+func (m *Matrix) GobDecode(data []byte) error {
+	return m.UnmarshalBinary(data)
+}
@@ -0,0 +1,132 @@
+package matrix
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"hash/crc32"
+	"math"
+	"testing"
+)
+
+// TestMarshalBinary tests the MarshalBinary and UnmarshalBinary methods
+func TestMarshalBinary(t *testing.T) {
+	m := NewMatrix(2, 3, [][]float64{
+		{1, -2.5, math.Inf(1)},
+		{0, 1e-300, 6},
+	})
+
+	// Test case 1: The layout has a header, the values and a checksum
+	data, err := m.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary returned an error: %v", err)
+	}
+	if len(data) != 24+6*8+4 || string(data[:4]) != "MTRX" {
+		t.Errorf("MarshalBinary produced unexpected data of length %d", len(data))
+	}
+	if binary.LittleEndian.Uint64(data[8:]) != 2 || binary.LittleEndian.Uint64(data[16:]) != 3 {
+		t.Errorf("MarshalBinary wrote the wrong shape")
+	}
+
+	// Test case 2: Round trip
+	var result2 Matrix
+	if err := result2.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary returned an error: %v", err)
+	}
+	if !matricesEqual(t, &m, &result2) {
+		t.Errorf("binary round trip failed")
+	}
+
+	// Test case 3: Every truncation is rejected without panicking
+	for n := 0; n < len(data); n++ {
+		var result Matrix
+		if err := result.UnmarshalBinary(data[:n]); err == nil {
+			t.Errorf("UnmarshalBinary should return an error for data truncated to %d bytes", n)
+		}
+	}
+
+	// Test case 4: Corrupted data
+	corrupted := bytes.Clone(data)
+	corrupted[30] ^= 0x01
+	var result4 Matrix
+	if err := result4.UnmarshalBinary(corrupted); !errors.Is(err, ErrBinaryChecksum) {
+		t.Errorf("UnmarshalBinary should return ErrBinaryChecksum for a flipped bit, got %v", err)
+	}
+
+	badMagic := bytes.Clone(data)
+	badMagic[0] = 'X'
+	if err := result4.UnmarshalBinary(badMagic); !errors.Is(err, ErrBinaryMagic) {
+		t.Errorf("UnmarshalBinary should return ErrBinaryMagic, got %v", err)
+	}
+
+	badVersion := bytes.Clone(data)
+	badVersion[4] = 9
+	if err := result4.UnmarshalBinary(badVersion); !errors.Is(err, ErrBinaryVersion) {
+		t.Errorf("UnmarshalBinary should return ErrBinaryVersion, got %v", err)
+	}
+
+	// Test case 5: A shape that overflows is rejected even with a valid checksum
+	huge := bytes.Clone(data[:24])
+	binary.LittleEndian.PutUint64(huge[8:], 1<<62)
+	binary.LittleEndian.PutUint64(huge[16:], 4)
+	huge = binary.LittleEndian.AppendUint32(huge, crc32.ChecksumIEEE(huge))
+	if err := result4.UnmarshalBinary(huge); !errors.Is(err, ErrBinaryLength) {
+		t.Errorf("UnmarshalBinary should return ErrBinaryLength for an overflowing shape, got %v", err)
+	}
+
+	// Test case 6: Matrices with no columns round-trip up to MaxEmptyRows rows
+	empty := zeroMatrix(2000000, 0)
+	emptyData, err := empty.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary returned an error for a 2000000x0 matrix: %v", err)
+	}
+	var result6 Matrix
+	if err := result6.UnmarshalBinary(emptyData); err != nil || result6.rows != 2000000 || result6.columns != 0 {
+		t.Errorf("UnmarshalBinary failed to round-trip a 2000000x0 matrix: %v", err)
+	}
+
+	tooMany := Matrix{rows: MaxEmptyRows + 1}
+	if _, err := tooMany.MarshalBinary(); !errors.Is(err, ErrBinaryLength) {
+		t.Errorf("MarshalBinary should return ErrBinaryLength for more than MaxEmptyRows rows, got %v", err)
+	}
+	binary.LittleEndian.PutUint64(emptyData[8:], MaxEmptyRows+1)
+	emptyData = binary.LittleEndian.AppendUint32(emptyData[:24], crc32.ChecksumIEEE(emptyData[:24]))
+	if err := result6.UnmarshalBinary(emptyData); !errors.Is(err, ErrBinaryLength) {
+		t.Errorf("UnmarshalBinary should return ErrBinaryLength for more than MaxEmptyRows rows, got %v", err)
+	}
+}
+
+// TestGobEncode tests gob encoding of a Matrix
+func TestGobEncode(t *testing.T) {
+	type factorization struct {
+		Name    string
+		Factors []Matrix
+	}
+
+	in := factorization{
+		Name: "lu",
+		Factors: []Matrix{
+			NewMatrix(2, 2, [][]float64{{1, 0}, {0.5, 1}}),
+			NewMatrix(2, 2, [][]float64{{2, 4}, {0, -1}}),
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatalf("gob encoding returned an error: %v", err)
+	}
+
+	var out factorization
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatalf("gob decoding returned an error: %v", err)
+	}
+	if out.Name != in.Name || len(out.Factors) != 2 {
+		t.Fatalf("gob round trip failed: got %+v", out)
+	}
+	for k := range in.Factors {
+		if !matricesEqual(t, &in.Factors[k], &out.Factors[k]) {
+			t.Errorf("gob round trip failed for factor %d", k)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Equal reports whether two matrices have the same dimensions and identical elements.
+// NaN elements are considered equal to NaN elements in the same position.
+func Equal(a, b Matrix) bool {
+	return EqualApprox(a, b, 0, 0)
+}
+
+// EqualApprox reports whether two matrices have the same dimensions and every pair of corresponding elements x and y
+// satisfies |x - y| <= absTol or |x - y| <= relTol·max(|x|, |y|). Infinite elements must match exactly,
+// and NaN elements are considered equal to NaN elements in the same position.
+func EqualApprox(a, b Matrix, absTol, relTol float64) bool {
+	// Check if the matrices have the same dimensions
+	if a.rows != b.rows || a.columns != b.columns {
+		return false
+	}
+
+	for i := 0; i < a.rows; i++ {
+		for j := 0; j < a.columns; j++ {
+			if !withinTolerance(a.values[i][j], b.values[i][j], absTol, relTol) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// withinTolerance reports whether x and y are equal within the absolute or relative tolerance, as described by EqualApprox.
+func withinTolerance(x, y, absTol, relTol float64) bool {
+	if x == y || (math.IsNaN(x) && math.IsNaN(y)) {
+		return true
+	}
+	if math.IsNaN(x) || math.IsNaN(y) || math.IsInf(x, 0) || math.IsInf(y, 0) {
+		return false
+	}
+
+	diff := math.Abs(x - y)
+	return diff <= absTol || diff <= relTol*math.Max(math.Abs(x), math.Abs(y))
+}
+
+// DiffEntry is a pair of corresponding elements that differ beyond the tolerance given to Diff.
+type DiffEntry struct {
+	Row    int
+	Column int
+	A      float64
+	B      float64
+}
+
+// MatrixDiff describes how two matrices differ. If the dimensions differ, Entries is empty;
+// otherwise it lists the differing elements in row-major order.
+type MatrixDiff struct {
+	ARows, AColumns int
+	BRows, BColumns int
+	Entries         []DiffEntry
+}
+
+// ShapeMismatch reports whether the two matrices have different dimensions.
+func (d MatrixDiff) ShapeMismatch() bool {
+	return d.ARows != d.BRows || d.AColumns != d.BColumns
+}
+
+// String describes the difference, listing each differing element on its own line.
+func (d MatrixDiff) String() string {
+	if d.ShapeMismatch() {
+		return fmt.Sprintf("shape mismatch: %dx%d vs %dx%d", d.ARows, d.AColumns, d.BRows, d.BColumns)
+	}
+
+	var sb strings.Builder
+	if len(d.Entries) == 1 {
+		sb.WriteString("1 element differs")
+	} else {
+		fmt.Fprintf(&sb, "%d elements differ", len(d.Entries))
+	}
+	for _, e := range d.Entries {
+		fmt.Fprintf(&sb, "\n  [%d][%d]: %v vs %v (difference %v)", e.Row, e.Column, e.A, e.B, e.A-e.B)
+	}
+
+	return sb.String()
+}
+
+// Diff compares two matrices with the same tolerances as EqualApprox and returns a pointer to a description
+// of their differences: either their mismatched dimensions or the elements that differ beyond the tolerance.
+// Returns nil if the matrices are equal within the tolerance.
+func Diff(a, b Matrix, absTol, relTol float64) *MatrixDiff {
+	d := &MatrixDiff{ARows: a.rows, AColumns: a.columns, BRows: b.rows, BColumns: b.columns}
+
+	// Check if the matrices have the same dimensions
+	if d.ShapeMismatch() {
+		return d
+	}
+
+	// Collect the elements that differ beyond the tolerance
+	for i := 0; i < a.rows; i++ {
+		for j := 0; j < a.columns; j++ {
+			if !withinTolerance(a.values[i][j], b.values[i][j], absTol, relTol) {
+				d.Entries = append(d.Entries, DiffEntry{Row: i, Column: j, A: a.values[i][j], B: b.values[i][j]})
+			}
+		}
+	}
+
+	if len(d.Entries) == 0 {
+		return nil
+	}
+	return d
+}
@@ -0,0 +1,88 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+)
+
+// TestEqual tests the Equal function
+func TestEqual(t *testing.T) {
+	a := MustParse("[1 2; 3 4]")
+
+	// Test case 1: Identical matrices
+	if !Equal(a, *Clone(a)) {
+		t.Errorf("Equal should accept identical matrices")
+	}
+
+	// Test case 2: Any difference in value or shape
+	if Equal(a, MustParse("[1 2; 3 4.000000001]")) || Equal(a, MustParse("[1 2 3 4]")) {
+		t.Errorf("Equal should reject matrices with different values or shapes")
+	}
+
+	// Test case 3: NaN equals NaN in the same position
+	n := NewMatrix(1, 2, [][]float64{{math.NaN(), math.Inf(1)}})
+	if !Equal(n, *Clone(n)) {
+		t.Errorf("Equal should treat NaN in the same position as equal")
+	}
+}
+
+// TestEqualApprox tests the EqualApprox function
+func TestEqualApprox(t *testing.T) {
+	a := MustParse("[1 1000; 0 -5]")
+
+	// Test case 1: Absolute tolerance
+	b := MustParse("[1.0001 1000; 0.0001 -5]")
+	if !EqualApprox(a, b, 1e-3, 0) || EqualApprox(a, b, 1e-5, 0) {
+		t.Errorf("EqualApprox failed for the absolute tolerance")
+	}
+
+	// Test case 2: Relative tolerance scales with the magnitude of the elements
+	c := MustParse("[1 1000.5; 0 -5]")
+	if !EqualApprox(a, c, 0, 1e-3) || EqualApprox(a, c, 0.1, 1e-4) {
+		t.Errorf("EqualApprox failed for the relative tolerance")
+	}
+
+	// Test case 3: Infinities must match exactly, whatever the tolerance
+	inf := NewMatrix(1, 1, [][]float64{{math.Inf(1)}})
+	big := NewMatrix(1, 1, [][]float64{{1e300}})
+	if !EqualApprox(inf, inf, 0, 0) || EqualApprox(inf, big, math.MaxFloat64, 1) {
+		t.Errorf("EqualApprox should compare infinities exactly")
+	}
+
+	// Test case 4: Different shapes
+	if EqualApprox(a, *TransposeMatrix(MustParse("[1 1000 0 -5]")), 1, 1) {
+		t.Errorf("EqualApprox should reject matrices with different shapes")
+	}
+}
+
+// TestDiff tests the Diff function
+func TestDiff(t *testing.T) {
+	a := MustParse("[1 2 3; 4 5 6]")
+
+	// Test case 1: Equal matrices have no diff
+	if d := Diff(a, MustParse("[1 2 3; 4 5 6.0000001]"), 1e-6, 0); d != nil {
+		t.Errorf("Diff should return nil for matrices within tolerance, got %v", d)
+	}
+
+	// Test case 2: Differing elements are listed in row-major order
+	d := Diff(a, MustParse("[1 2.5 3; 4 5 7]"), 1e-6, 0)
+	if d == nil || d.ShapeMismatch() || len(d.Entries) != 2 {
+		t.Fatalf("Diff should report two differing elements, got %v", d)
+	}
+	if d.Entries[0] != (DiffEntry{Row: 0, Column: 1, A: 2, B: 2.5}) || d.Entries[1] != (DiffEntry{Row: 1, Column: 2, A: 6, B: 7}) {
+		t.Errorf("Diff reported the wrong entries: %v", d.Entries)
+	}
+	expected := "2 elements differ\n  [0][1]: 2 vs 2.5 (difference -0.5)\n  [1][2]: 6 vs 7 (difference -1)"
+	if d.String() != expected {
+		t.Errorf("MatrixDiff.String failed: expected\n%s\ngot\n%s", expected, d.String())
+	}
+
+	// Test case 3: Shape mismatches are reported without entries
+	d = Diff(a, *TransposeMatrix(a), 0, 0)
+	if d == nil || !d.ShapeMismatch() || len(d.Entries) != 0 {
+		t.Fatalf("Diff should report a shape mismatch, got %v", d)
+	}
+	if d.String() != "shape mismatch: 2x3 vs 3x2" {
+		t.Errorf("MatrixDiff.String failed for a shape mismatch: got %q", d.String())
+	}
+}
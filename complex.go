@@ -0,0 +1,228 @@
+package matrix
+
+import (
+	"math/cmplx"
+)
+
+// ComplexMatrix is a matrix of complex128 elements. The generic functions AddDense, SubtractDense, MultiplyDense,
+// TransposeDense and the Dense row operations provide its arithmetic; this file adds the operations
+// that are specific to complex matrices.
+type ComplexMatrix = Dense[complex128]
+
+// NewComplexMatrix creates a rows × cols complex matrix with a copy of the given values.
+func NewComplexMatrix(rows, cols int, values [][]complex128) ComplexMatrix {
+	return NewDense(rows, cols, values)
+}
+
+// ComplexMatrixFromMatrix converts a real matrix to a complex matrix with zero imaginary parts.
+func ComplexMatrixFromMatrix(m Matrix) ComplexMatrix {
+	result := newDense[complex128](m.rows, m.columns)
+	for i := 0; i < m.rows; i++ {
+		for j := 0; j < m.columns; j++ {
+			result.values[i][j] = complex(m.values[i][j], 0)
+		}
+	}
+	return result
+}
+
+// ConjugateTranspose transposes a complex matrix and conjugates every element,
+// and returns a pointer to the resulting matrix.
+func ConjugateTranspose(m ComplexMatrix) *ComplexMatrix {
+	result := newDense[complex128](m.columns, m.rows)
+	for i := 0; i < m.columns; i++ {
+		for j := 0; j < m.rows; j++ {
+			result.values[i][j] = cmplx.Conj(m.values[j][i])
+		}
+	}
+
+	return &result
+}
+
+// IsHermitian reports whether a complex matrix is square and equal to its conjugate transpose,
+// with every element within tol of the conjugate of its mirror image.
+func IsHermitian(m ComplexMatrix, tol float64) bool {
+	if m.rows != m.columns {
+		return false
+	}
+
+	for i := 0; i < m.rows; i++ {
+		for j := 0; j <= i; j++ {
+			if cmplx.Abs(m.values[i][j]-cmplx.Conj(m.values[j][i])) > tol {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// complexEliminate performs Gaussian elimination on a copy of a complex matrix, or Gauss-Jordan elimination
+// if reduced is true, choosing pivots the same way as RowEchelonForm.
+func complexEliminate(m ComplexMatrix, reduced bool) *ComplexMatrix {
+	result := cloneDense(m)
+
+	// Current row being processed
+	row := 0
+
+	// Process each column
+	for col := 0; col < m.columns && row < m.rows; col++ {
+		// Find the pivot row (first row with non-zero element in current column)
+		pivotRow := -1
+		for i := row; i < m.rows; i++ {
+			if result.values[i][col] != 0 {
+				pivotRow = i
+				break
+			}
+		}
+
+		// If no pivot found in this column, move to the next column
+		if pivotRow == -1 {
+			continue
+		}
+
+		// Swap the pivot row with the current row if they're different
+		result.values[row], result.values[pivotRow] = result.values[pivotRow], result.values[row]
+
+		// Scale the pivot row to make the pivot element 1
+		inverse := 1 / result.values[row][col]
+		for j := col; j < m.columns; j++ {
+			result.values[row][j] *= inverse
+		}
+		result.values[row][col] = 1
+
+		// Eliminate the other elements in the current column
+		start := row + 1
+		if reduced {
+			start = 0
+		}
+		for i := start; i < m.rows; i++ {
+			if i == row || result.values[i][col] == 0 {
+				continue
+			}
+			factor := result.values[i][col]
+			for j := col; j < m.columns; j++ {
+				result.values[i][j] -= factor * result.values[row][j]
+			}
+		}
+
+		// Move to the next row
+		row++
+	}
+
+	return result
+}
+
+// ComplexRowEchelonForm performs Gaussian elimination on a complex matrix
+// and returns a pointer to the resulting matrix in row echelon form.
+func ComplexRowEchelonForm(m ComplexMatrix) *ComplexMatrix {
+	return complexEliminate(m, false)
+}
+
+// ComplexReducedRowEchelonForm performs Gauss-Jordan elimination on a complex matrix
+// and returns a pointer to the resulting matrix in reduced row echelon form.
+func ComplexReducedRowEchelonForm(m ComplexMatrix) *ComplexMatrix {
+	return complexEliminate(m, true)
+}
+
+// ComplexLU is the LU decomposition with partial pivoting of a square complex matrix A,
+// such that row i of L·U is row Pivot[i] of A. L is unit lower triangular and U is upper triangular.
+type ComplexLU struct {
+	L     ComplexMatrix
+	U     ComplexMatrix
+	Pivot []int
+}
+
+// ComplexLUDecompose computes the LU decomposition of a square complex matrix with partial pivoting
+// and returns a pointer to it.
+// Returns nil if the matrix is not square or is singular.
+func ComplexLUDecompose(a ComplexMatrix) *ComplexLU {
+	if a.rows != a.columns {
+		return nil
+	}
+	n := a.rows
+
+	u := cloneDense(a)
+	l := newDense[complex128](n, n)
+	pivot := make([]int, n)
+	for i := range pivot {
+		pivot[i] = i
+	}
+
+	for col := 0; col < n; col++ {
+		// Choose the row with the largest element in this column as the pivot
+		best := col
+		for i := col + 1; i < n; i++ {
+			if cmplx.Abs(u.values[i][col]) > cmplx.Abs(u.values[best][col]) {
+				best = i
+			}
+		}
+		if u.values[best][col] == 0 {
+			return nil
+		}
+
+		// Move the pivot row into place in U, L and the permutation
+		u.values[col], u.values[best] = u.values[best], u.values[col]
+		l.values[col], l.values[best] = l.values[best], l.values[col]
+		pivot[col], pivot[best] = pivot[best], pivot[col]
+
+		// Eliminate below the pivot, storing the multipliers in L
+		for i := col + 1; i < n; i++ {
+			factor := u.values[i][col] / u.values[col][col]
+			l.values[i][col] = factor
+			for j := col; j < n; j++ {
+				u.values[i][j] -= factor * u.values[col][j]
+			}
+			u.values[i][col] = 0
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		l.values[i][i] = 1
+	}
+
+	return &ComplexLU{L: l, U: *u, Pivot: pivot}
+}
+
+// ComplexLUSolve solves A·X = B for X using the LU decomposition of A and returns a pointer to X.
+// Returns nil if B doesn't have the same number of rows as A.
+func ComplexLUSolve(lu ComplexLU, b ComplexMatrix) *ComplexMatrix {
+	n := lu.U.rows
+	if b.rows != n {
+		return nil
+	}
+
+	// Apply the row permutation to B
+	x := newDense[complex128](n, b.columns)
+	for i := 0; i < n; i++ {
+		copy(x.values[i], b.values[lu.Pivot[i]])
+	}
+
+	for c := 0; c < b.columns; c++ {
+		// Forward substitution with the unit lower triangular L
+		for i := 0; i < n; i++ {
+			for k := 0; k < i; k++ {
+				x.values[i][c] -= lu.L.values[i][k] * x.values[k][c]
+			}
+		}
+
+		// Back substitution with the upper triangular U
+		for i := n - 1; i >= 0; i-- {
+			for k := i + 1; k < n; k++ {
+				x.values[i][c] -= lu.U.values[i][k] * x.values[k][c]
+			}
+			x.values[i][c] /= lu.U.values[i][i]
+		}
+	}
+
+	return &x
+}
+
+// ComplexSolve solves A·X = B for X and returns a pointer to X.
+// Returns nil if A is not square or is singular, or if B doesn't have the same number of rows as A.
+func ComplexSolve(a, b ComplexMatrix) *ComplexMatrix {
+	lu := ComplexLUDecompose(a)
+	if lu == nil {
+		return nil
+	}
+	return ComplexLUSolve(*lu, b)
+}
@@ -0,0 +1,163 @@
+package matrix
+
+import (
+	"math/cmplx"
+	"testing"
+)
+
+// complexApproxEqual compares two complex matrices element by element within tol.
+func complexApproxEqual(t *testing.T, expected, actual *ComplexMatrix, tol float64) bool {
+	if expected == nil || actual == nil {
+		if expected != actual {
+			t.Errorf("One matrix is nil and the other is not")
+			return false
+		}
+		return true
+	}
+
+	if expected.rows != actual.rows || expected.columns != actual.columns {
+		t.Errorf("Matrix dimensions don't match: expected %dx%d, got %dx%d",
+			expected.rows, expected.columns, actual.rows, actual.columns)
+		return false
+	}
+
+	for i := 0; i < expected.rows; i++ {
+		for j := 0; j < expected.columns; j++ {
+			if cmplx.Abs(expected.values[i][j]-actual.values[i][j]) > tol {
+				t.Errorf("Matrix values don't match at position [%d][%d]: expected %v, got %v",
+					i, j, expected.values[i][j], actual.values[i][j])
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// TestConjugateTranspose tests the ConjugateTranspose function
+func TestConjugateTranspose(t *testing.T) {
+	m := NewComplexMatrix(2, 3, [][]complex128{
+		{1 + 2i, 3, -1i},
+		{0, 4 - 1i, 5 + 5i},
+	})
+
+	expected := NewComplexMatrix(3, 2, [][]complex128{
+		{1 - 2i, 0},
+		{3, 4 + 1i},
+		{1i, 5 - 5i},
+	})
+
+	if !denseEqual(t, &expected, ConjugateTranspose(m)) {
+		t.Errorf("ConjugateTranspose failed for 2x3 matrix")
+	}
+}
+
+// TestIsHermitian tests the IsHermitian function
+func TestIsHermitian(t *testing.T) {
+	// Test case 1: A Pauli matrix is Hermitian
+	pauliY := NewComplexMatrix(2, 2, [][]complex128{
+		{0, -1i},
+		{1i, 0},
+	})
+	if !IsHermitian(pauliY, 0) {
+		t.Errorf("IsHermitian should accept the Pauli Y matrix")
+	}
+
+	// Test case 2: A symmetric matrix with complex entries is not Hermitian
+	symmetric := NewComplexMatrix(2, 2, [][]complex128{
+		{1, 1i},
+		{1i, 1},
+	})
+	if IsHermitian(symmetric, 1e-12) {
+		t.Errorf("IsHermitian should reject a complex symmetric matrix")
+	}
+
+	// Test case 3: The diagonal must be real, within the tolerance
+	nearly := NewComplexMatrix(1, 1, [][]complex128{{2 + 1e-14i}})
+	if !IsHermitian(nearly, 1e-12) || IsHermitian(nearly, 0) {
+		t.Errorf("IsHermitian should respect the tolerance on the diagonal")
+	}
+
+	// Test case 4: Non-square matrices are not Hermitian
+	if IsHermitian(NewComplexMatrix(1, 2, [][]complex128{{1, 1}}), 1) {
+		t.Errorf("IsHermitian should reject a non-square matrix")
+	}
+}
+
+// TestComplexEchelonForms tests ComplexRowEchelonForm and ComplexReducedRowEchelonForm
+func TestComplexEchelonForms(t *testing.T) {
+	m := NewComplexMatrix(2, 3, [][]complex128{
+		{0, 2i, 2},
+		{1i, 1, 1i},
+	})
+
+	// Test case 1: Row echelon form swaps to find a pivot and scales it to 1
+	ref := NewComplexMatrix(2, 3, [][]complex128{
+		{1, -1i, 1},
+		{0, 1, -1i},
+	})
+	if !complexApproxEqual(t, &ref, ComplexRowEchelonForm(m), 1e-12) {
+		t.Errorf("ComplexRowEchelonForm failed")
+	}
+
+	// Test case 2: Reduced row echelon form also clears above the pivots
+	rref := NewComplexMatrix(2, 3, [][]complex128{
+		{1, 0, 2},
+		{0, 1, -1i},
+	})
+	if !complexApproxEqual(t, &rref, ComplexReducedRowEchelonForm(m), 1e-12) {
+		t.Errorf("ComplexReducedRowEchelonForm failed")
+	}
+
+	// Test case 3: Real matrices give the same result as the real functions
+	r := MustParse("[2 1 -1 8; -3 -1 2 -11; -2 1 2 -3]")
+	expected := ComplexMatrixFromMatrix(*ReducedRowEchelonForm(r))
+	if !complexApproxEqual(t, &expected, ComplexReducedRowEchelonForm(ComplexMatrixFromMatrix(r)), 1e-12) {
+		t.Errorf("ComplexReducedRowEchelonForm does not match ReducedRowEchelonForm for a real matrix")
+	}
+}
+
+// TestComplexLU tests ComplexLUDecompose, ComplexLUSolve and ComplexSolve
+func TestComplexLU(t *testing.T) {
+	a := NewComplexMatrix(3, 3, [][]complex128{
+		{1i, 2, 0},
+		{3, 1 + 1i, -1},
+		{0, 1, 2 - 1i},
+	})
+
+	// Test case 1: L·U reproduces the permuted matrix
+	lu := ComplexLUDecompose(a)
+	if lu == nil {
+		t.Fatalf("ComplexLUDecompose returned nil for a non-singular matrix")
+	}
+	permuted := newDense[complex128](3, 3)
+	for i := 0; i < 3; i++ {
+		copy(permuted.values[i], a.values[lu.Pivot[i]])
+	}
+	if !complexApproxEqual(t, &permuted, MultiplyDense(lu.L, lu.U), 1e-12) {
+		t.Errorf("ComplexLUDecompose: L·U does not equal the permuted matrix")
+	}
+	if lu.Pivot[0] != 1 {
+		t.Errorf("ComplexLUDecompose should choose the largest pivot, got pivot order %v", lu.Pivot)
+	}
+
+	// Test case 2: Solving with a known solution
+	x := NewComplexMatrix(3, 2, [][]complex128{
+		{1, 1i},
+		{-1i, 2},
+		{2 + 1i, 0},
+	})
+	b := MultiplyDense(a, x)
+	if !complexApproxEqual(t, &x, ComplexSolve(a, *b), 1e-12) {
+		t.Errorf("ComplexSolve failed to recover the known solution")
+	}
+
+	// Test case 3: Invalid inputs
+	singular := NewComplexMatrix(2, 2, [][]complex128{{1, 1i}, {1i, -1}})
+	if ComplexLUDecompose(singular) != nil {
+		t.Errorf("ComplexLUDecompose should return nil for a singular matrix")
+	}
+	if ComplexLUSolve(*lu, NewComplexMatrix(2, 1, [][]complex128{{1}, {1}})) != nil {
+		t.Errorf("ComplexLUSolve should return nil for a right-hand side with the wrong number of rows")
+	}
+}
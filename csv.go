@@ -0,0 +1,178 @@
+package matrix
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// CSVOptions configures ReadCSV and WriteCSV. The zero value reads and writes plain comma-separated values.
+type CSVOptions struct {
+	// Comma is the field delimiter. It defaults to ',' and can be set to '\t' for TSV.
+	Comma rune
+	// Comment, if not 0, marks lines that are ignored when reading.
+	Comment rune
+
+	// HasHeader reports whether the first record holds column labels when reading.
+	HasHeader bool
+	// HasRowLabels reports whether the first field of every record holds a row label when reading.
+	HasRowLabels bool
+
+	// ColumnLabels, if not nil, is written as a header record.
+	ColumnLabels []string
+	// RowLabels, if not nil, is written as the first field of every record.
+	RowLabels []string
+	// Format and Precision are passed to strconv.FormatFloat when writing.
+	// A zero Format writes the shortest representation that reads back exactly.
+	Format    byte
+	Precision int
+}
+
+// CSVLabels holds the labels read alongside the data by ReadCSV.
+type CSVLabels struct {
+	Columns []string
+	Rows    []string
+}
+
+// CSVError describes a value that could not be parsed. Row and Column are the 1-based position
+// of the value in the matrix and Line is the line of the input it was read from.
+type CSVError struct {
+	Line   int
+	Row    int
+	Column int
+	Err    error
+}
+
+func (e *CSVError) Error() string {
+	return fmt.Sprintf("matrix: csv line %d, row %d, column %d: %v", e.Line, e.Row, e.Column, e.Err)
+}
+
+func (e *CSVError) Unwrap() error {
+	return e.Err
+}
+
+// ReadCSV reads a matrix from delimiter-separated values, one record per row.
+// Values are parsed with strconv.ParseFloat, so the format does not depend on the locale
+// and NaN and ±Inf are accepted. Every record must have the same number of fields.
+func ReadCSV(r io.Reader, opts CSVOptions) (*Matrix, CSVLabels, error) {
+	reader := csv.NewReader(r)
+	if opts.Comma != 0 {
+		reader.Comma = opts.Comma
+	}
+	reader.Comment = opts.Comment
+
+	var labels CSVLabels
+
+	// The first column holds row labels when they are present
+	offset := 0
+	if opts.HasRowLabels {
+		offset = 1
+	}
+
+	values := [][]float64{}
+	first := true
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, CSVLabels{}, err
+		}
+
+		if first && opts.HasHeader {
+			first = false
+			labels.Columns = append([]string(nil), record[min(offset, len(record)):]...)
+			continue
+		}
+		first = false
+
+		if len(record) <= offset {
+			line, _ := reader.FieldPos(0)
+			return nil, CSVLabels{}, &CSVError{Line: line, Row: len(values) + 1, Column: 1, Err: errors.New("missing values")}
+		}
+		if opts.HasRowLabels {
+			labels.Rows = append(labels.Rows, record[0])
+		}
+
+		row := make([]float64, len(record)-offset)
+		for j := range row {
+			v, err := strconv.ParseFloat(strings.TrimSpace(record[j+offset]), 64)
+			if err != nil {
+				line, _ := reader.FieldPos(j + offset)
+				return nil, CSVLabels{}, &CSVError{Line: line, Row: len(values) + 1, Column: j + 1, Err: err}
+			}
+			row[j] = v
+		}
+		values = append(values, row)
+	}
+
+	columns := 0
+	if len(values) > 0 {
+		columns = len(values[0])
+	}
+	if opts.HasHeader && len(values) > 0 && len(labels.Columns) != columns {
+		return nil, CSVLabels{}, fmt.Errorf("matrix: csv header has %d labels, expected %d", len(labels.Columns), columns)
+	}
+
+	result := &Matrix{
+		rows:    len(values),
+		columns: columns,
+		values:  values,
+	}
+	return result, labels, nil
+}
+
+// WriteCSV writes the matrix as delimiter-separated values, one record per row.
+// Values are formatted with strconv.FormatFloat, so the output does not depend on the locale.
+func WriteCSV(w io.Writer, m Matrix, opts CSVOptions) error {
+	// Check if the labels match the dimensions of the matrix
+	if opts.ColumnLabels != nil && len(opts.ColumnLabels) != m.columns {
+		return fmt.Errorf("matrix: csv has %d column labels, expected %d", len(opts.ColumnLabels), m.columns)
+	}
+	if opts.RowLabels != nil && len(opts.RowLabels) != m.rows {
+		return fmt.Errorf("matrix: csv has %d row labels, expected %d", len(opts.RowLabels), m.rows)
+	}
+
+	format, precision := opts.Format, opts.Precision
+	if format == 0 {
+		format, precision = 'g', -1
+	}
+
+	writer := csv.NewWriter(w)
+	if opts.Comma != 0 {
+		writer.Comma = opts.Comma
+	}
+
+	// Write the header, leaving the corner empty when there are row labels
+	if opts.ColumnLabels != nil {
+		record := make([]string, 0, m.columns+1)
+		if opts.RowLabels != nil {
+			record = append(record, "")
+		}
+		record = append(record, opts.ColumnLabels...)
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	// Write each row of values
+	for i := 0; i < m.rows; i++ {
+		record := make([]string, 0, m.columns+1)
+		if opts.RowLabels != nil {
+			record = append(record, opts.RowLabels[i])
+		}
+		for j := 0; j < m.columns; j++ {
+			record = append(record, strconv.FormatFloat(m.values[i][j], format, precision, 64))
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
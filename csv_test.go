@@ -0,0 +1,116 @@
+package matrix
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+// TestReadCSV tests the ReadCSV function
+func TestReadCSV(t *testing.T) {
+	// Test case 1: Plain comma-separated values
+	expected1 := &Matrix{
+		rows:    2,
+		columns: 3,
+		values: [][]float64{
+			{1, 2.5, -3},
+			{4e2, 5, 6},
+		},
+	}
+
+	result1, _, err := ReadCSV(strings.NewReader("1,2.5,-3\n4e2, 5 ,6\n"), CSVOptions{})
+	if err != nil {
+		t.Fatalf("ReadCSV returned an error: %v", err)
+	}
+	if !matricesEqual(t, expected1, result1) {
+		t.Errorf("ReadCSV failed for plain values")
+	}
+
+	// Test case 2: TSV with a header, row labels and comments
+	input2 := "# exported data\n" +
+		"\tx\ty\n" +
+		"a\t1\t2\n" +
+		"# skipped\n" +
+		"b\t3\tNaN\n"
+
+	result2, labels2, err := ReadCSV(strings.NewReader(input2), CSVOptions{
+		Comma:        '\t',
+		Comment:      '#',
+		HasHeader:    true,
+		HasRowLabels: true,
+	})
+	if err != nil {
+		t.Fatalf("ReadCSV returned an error: %v", err)
+	}
+	if result2.rows != 2 || result2.columns != 2 || result2.values[1][0] != 3 || !math.IsNaN(result2.values[1][1]) {
+		t.Errorf("ReadCSV failed for TSV values: got %v", result2.values)
+	}
+	if strings.Join(labels2.Columns, ",") != "x,y" || strings.Join(labels2.Rows, ",") != "a,b" {
+		t.Errorf("ReadCSV failed to read labels: got %+v", labels2)
+	}
+
+	// Test case 3: Parse errors report the position of the value
+	_, _, err = ReadCSV(strings.NewReader("1,2\n3,x\n"), CSVOptions{})
+	var csvErr *CSVError
+	if !errors.As(err, &csvErr) {
+		t.Fatalf("ReadCSV should return a CSVError for an invalid value, got %v", err)
+	}
+	if csvErr.Line != 2 || csvErr.Row != 2 || csvErr.Column != 2 {
+		t.Errorf("ReadCSV reported the wrong position: line %d, row %d, column %d", csvErr.Line, csvErr.Row, csvErr.Column)
+	}
+
+	// Test case 4: Ragged records
+	if _, _, err := ReadCSV(strings.NewReader("1,2\n3\n"), CSVOptions{}); err == nil {
+		t.Errorf("ReadCSV should return an error for ragged records")
+	}
+}
+
+// TestWriteCSV tests the WriteCSV function
+func TestWriteCSV(t *testing.T) {
+	m := NewMatrix(2, 2, [][]float64{
+		{1, 0.1},
+		{-2.5, 1e21},
+	})
+
+	// Test case 1: Shortest representation
+	var buf1 bytes.Buffer
+	if err := WriteCSV(&buf1, m, CSVOptions{}); err != nil {
+		t.Fatalf("WriteCSV returned an error: %v", err)
+	}
+	if expected1 := "1,0.1\n-2.5,1e+21\n"; buf1.String() != expected1 {
+		t.Errorf("WriteCSV failed: expected %q, got %q", expected1, buf1.String())
+	}
+
+	// Test case 2: Labels, delimiter and fixed precision
+	var buf2 bytes.Buffer
+	err := WriteCSV(&buf2, m, CSVOptions{
+		Comma:        ';',
+		ColumnLabels: []string{"x", "y"},
+		RowLabels:    []string{"a", "b"},
+		Format:       'f',
+		Precision:    2,
+	})
+	if err != nil {
+		t.Fatalf("WriteCSV returned an error: %v", err)
+	}
+	expected2 := ";x;y\na;1.00;0.10\nb;-2.50;1000000000000000000000.00\n"
+	if buf2.String() != expected2 {
+		t.Errorf("WriteCSV failed with options: expected %q, got %q", expected2, buf2.String())
+	}
+
+	// Test case 3: Round trip
+	result3, labels3, err := ReadCSV(&buf2, CSVOptions{Comma: ';', HasHeader: true, HasRowLabels: true})
+	if err != nil {
+		t.Fatalf("ReadCSV returned an error: %v", err)
+	}
+	if !matricesEqual(t, &m, result3) || len(labels3.Rows) != 2 {
+		t.Errorf("WriteCSV and ReadCSV round trip failed")
+	}
+
+	// Test case 4: Mismatched labels
+	if err := WriteCSV(&bytes.Buffer{}, m, CSVOptions{RowLabels: []string{"a"}}); err == nil {
+		t.Errorf("WriteCSV should return an error for the wrong number of row labels")
+	}
+}
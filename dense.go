@@ -0,0 +1,233 @@
+package matrix
+
+import (
+	"fmt"
+)
+
+// Numeric is the set of element types supported by Dense.
+type Numeric interface {
+	~int | ~float32 | ~float64 | ~complex128
+}
+
+// Dense is a matrix with elements of any Numeric type, for data such as integer adjacency matrices
+// or float32 samples that should not be widened to float64. Dense[float64] converts to and from Matrix.
+type Dense[T Numeric] struct {
+	rows    int
+	columns int
+	values  [][]T
+}
+
+// NewDense creates a rows × cols matrix with a copy of the given values.
+func NewDense[T Numeric](rows, cols int, values [][]T) Dense[T] {
+	m := newDense[T](rows, cols)
+
+	for i := 0; i < rows; i++ {
+		copy(m.values[i], values[i][:cols])
+	}
+
+	return m
+}
+
+// newDense creates a rows × cols matrix of zeros.
+func newDense[T Numeric](rows, cols int) Dense[T] {
+	m := Dense[T]{
+		rows:    rows,
+		columns: cols,
+		values:  make([][]T, rows),
+	}
+
+	for i := 0; i < rows; i++ {
+		m.values[i] = make([]T, cols)
+	}
+
+	return m
+}
+
+// cloneDense returns a pointer to a deep copy of the matrix.
+func cloneDense[T Numeric](m Dense[T]) *Dense[T] {
+	result := NewDense(m.rows, m.columns, m.values)
+	return &result
+}
+
+// String returns the matrix rendered with aligned columns.
+func (m Dense[T]) String() string {
+	return renderMatrix(m.rows, m.columns, func(i, j int) string {
+		return fmt.Sprint(m.values[i][j])
+	}, 0, true)
+}
+
+// At returns the element at row i and column j.
+func (m Dense[T]) At(i, j int) T {
+	return m.values[i][j]
+}
+
+// DenseFromMatrix converts a Matrix to a Dense[float64].
+func DenseFromMatrix(m Matrix) Dense[float64] {
+	return NewDense(m.rows, m.columns, m.values)
+}
+
+// MatrixFromDense converts a Dense[float64] to a Matrix.
+func MatrixFromDense(m Dense[float64]) Matrix {
+	return NewMatrix(m.rows, m.columns, m.values)
+}
+
+// AddDense adds two matrices and returns a pointer to the resulting matrix.
+// Returns nil if the matrices have different dimensions.
+func AddDense[T Numeric](a, b Dense[T]) *Dense[T] {
+	// Check if matrices have the same dimensions
+	if a.rows != b.rows || a.columns != b.columns {
+		return nil
+	}
+
+	result := newDense[T](a.rows, a.columns)
+	for i := 0; i < a.rows; i++ {
+		for j := 0; j < a.columns; j++ {
+			result.values[i][j] = a.values[i][j] + b.values[i][j]
+		}
+	}
+
+	return &result
+}
+
+// SubtractDense subtracts the second matrix from the first and returns a pointer to the resulting matrix.
+// Returns nil if the matrices have different dimensions.
+func SubtractDense[T Numeric](a, b Dense[T]) *Dense[T] {
+	// Check if matrices have the same dimensions
+	if a.rows != b.rows || a.columns != b.columns {
+		return nil
+	}
+
+	result := newDense[T](a.rows, a.columns)
+	for i := 0; i < a.rows; i++ {
+		for j := 0; j < a.columns; j++ {
+			result.values[i][j] = a.values[i][j] - b.values[i][j]
+		}
+	}
+
+	return &result
+}
+
+// MultiplyDense multiplies two matrices and returns a pointer to the resulting matrix.
+// Returns nil if the number of columns in the first matrix does not equal the number of rows in the second matrix.
+func MultiplyDense[T Numeric](a, b Dense[T]) *Dense[T] {
+	// Check if matrices can be multiplied
+	if a.columns != b.rows {
+		return nil
+	}
+
+	result := newDense[T](a.rows, b.columns)
+	for i := 0; i < a.rows; i++ {
+		for j := 0; j < b.columns; j++ {
+			// Calculate the dot product of row i from matrix a and column j from matrix b
+			var sum T
+			for k := 0; k < a.columns; k++ {
+				sum += a.values[i][k] * b.values[k][j]
+			}
+			result.values[i][j] = sum
+		}
+	}
+
+	return &result
+}
+
+// TransposeDense transposes a matrix and returns a pointer to the resulting matrix.
+func TransposeDense[T Numeric](m Dense[T]) *Dense[T] {
+	result := newDense[T](m.columns, m.rows)
+	for i := 0; i < m.columns; i++ {
+		for j := 0; j < m.rows; j++ {
+			result.values[i][j] = m.values[j][i]
+		}
+	}
+
+	return &result
+}
+
+// SwapDenseRows swaps two rows in the matrix and returns a pointer to the resulting matrix.
+// Returns nil if either row index is out of bounds.
+func SwapDenseRows[T Numeric](m Dense[T], row1, row2 int) *Dense[T] {
+	// Check if row indices are valid
+	if row1 < 0 || row1 >= m.rows || row2 < 0 || row2 >= m.rows {
+		return nil
+	}
+
+	result := cloneDense(m)
+	result.values[row1], result.values[row2] = result.values[row2], result.values[row1]
+	return result
+}
+
+// SwapDenseColumns swaps two columns in the matrix and returns a pointer to the resulting matrix.
+// Returns nil if either column index is out of bounds.
+func SwapDenseColumns[T Numeric](m Dense[T], col1, col2 int) *Dense[T] {
+	// Check if column indices are valid
+	if col1 < 0 || col1 >= m.columns || col2 < 0 || col2 >= m.columns {
+		return nil
+	}
+
+	result := cloneDense(m)
+	for i := 0; i < m.rows; i++ {
+		result.values[i][col1], result.values[i][col2] = result.values[i][col2], result.values[i][col1]
+	}
+	return result
+}
+
+// MultiplyDenseRow multiplies a row in the matrix by a scalar value and returns a pointer to the resulting matrix.
+// Returns nil if the row index is out of bounds.
+func MultiplyDenseRow[T Numeric](m Dense[T], row int, scalar T) *Dense[T] {
+	// Check if row index is valid
+	if row < 0 || row >= m.rows {
+		return nil
+	}
+
+	result := cloneDense(m)
+	for j := 0; j < m.columns; j++ {
+		result.values[row][j] *= scalar
+	}
+	return result
+}
+
+// MultiplyDenseColumn multiplies a column in the matrix by a scalar value and returns a pointer to the resulting matrix.
+// Returns nil if the column index is out of bounds.
+func MultiplyDenseColumn[T Numeric](m Dense[T], col int, scalar T) *Dense[T] {
+	// Check if column index is valid
+	if col < 0 || col >= m.columns {
+		return nil
+	}
+
+	result := cloneDense(m)
+	for i := 0; i < m.rows; i++ {
+		result.values[i][col] *= scalar
+	}
+	return result
+}
+
+// AddScaledDenseRow adds a source row multiplied by a scalar to a target row in the matrix
+// and returns a pointer to the resulting matrix.
+// Returns nil if either row index is out of bounds.
+func AddScaledDenseRow[T Numeric](m Dense[T], targetRow, sourceRow int, scalar T) *Dense[T] {
+	// Check if row indices are valid
+	if targetRow < 0 || targetRow >= m.rows || sourceRow < 0 || sourceRow >= m.rows {
+		return nil
+	}
+
+	result := cloneDense(m)
+	for j := 0; j < m.columns; j++ {
+		result.values[targetRow][j] += m.values[sourceRow][j] * scalar
+	}
+	return result
+}
+
+// AddScaledDenseColumn adds a source column multiplied by a scalar to a target column in the matrix
+// and returns a pointer to the resulting matrix.
+// Returns nil if either column index is out of bounds.
+func AddScaledDenseColumn[T Numeric](m Dense[T], targetCol, sourceCol int, scalar T) *Dense[T] {
+	// Check if column indices are valid
+	if targetCol < 0 || targetCol >= m.columns || sourceCol < 0 || sourceCol >= m.columns {
+		return nil
+	}
+
+	result := cloneDense(m)
+	for i := 0; i < m.rows; i++ {
+		result.values[i][targetCol] += m.values[i][sourceCol] * scalar
+	}
+	return result
+}
@@ -0,0 +1,166 @@
+package matrix
+
+import (
+	"testing"
+)
+
+// denseEqual compares two generic matrices exactly.
+func denseEqual[T Numeric](t *testing.T, expected, actual *Dense[T]) bool {
+	if expected == nil && actual == nil {
+		return true
+	}
+
+	if expected == nil || actual == nil {
+		t.Errorf("One matrix is nil and the other is not")
+		return false
+	}
+
+	if expected.rows != actual.rows || expected.columns != actual.columns {
+		t.Errorf("Matrix dimensions don't match: expected %dx%d, got %dx%d",
+			expected.rows, expected.columns, actual.rows, actual.columns)
+		return false
+	}
+
+	for i := 0; i < expected.rows; i++ {
+		for j := 0; j < expected.columns; j++ {
+			if expected.values[i][j] != actual.values[i][j] {
+				t.Errorf("Matrix values don't match at position [%d][%d]: expected %v, got %v",
+					i, j, expected.values[i][j], actual.values[i][j])
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// TestDenseInt tests arithmetic on integer matrices
+func TestDenseInt(t *testing.T) {
+	// Test case 1: Counting paths of length 2 in a directed graph
+	adjacency := NewDense(3, 3, [][]int{
+		{0, 1, 1},
+		{0, 0, 1},
+		{1, 0, 0},
+	})
+
+	expected1 := NewDense(3, 3, [][]int{
+		{1, 0, 1},
+		{1, 0, 0},
+		{0, 1, 1},
+	})
+
+	if !denseEqual(t, &expected1, MultiplyDense(adjacency, adjacency)) {
+		t.Errorf("MultiplyDense failed for integer matrices")
+	}
+
+	// Test case 2: Addition, subtraction and transposition
+	expected2 := NewDense(3, 3, [][]int{
+		{0, 1, 2},
+		{1, 0, 1},
+		{2, 1, 0},
+	})
+	if !denseEqual(t, &expected2, AddDense(adjacency, *TransposeDense(adjacency))) {
+		t.Errorf("AddDense and TransposeDense failed for integer matrices")
+	}
+
+	zero := NewDense(3, 3, [][]int{{0, 0, 0}, {0, 0, 0}, {0, 0, 0}})
+	if !denseEqual(t, &zero, SubtractDense(adjacency, adjacency)) {
+		t.Errorf("SubtractDense failed for integer matrices")
+	}
+
+	// Test case 3: Mismatched dimensions
+	other := NewDense(2, 2, [][]int{{1, 2}, {3, 4}})
+	if AddDense(adjacency, other) != nil || MultiplyDense(adjacency, other) != nil {
+		t.Errorf("Dense arithmetic should return nil for mismatched dimensions")
+	}
+}
+
+// TestDenseRowOperations tests the row and column operations on float32 matrices
+func TestDenseRowOperations(t *testing.T) {
+	m := NewDense(2, 2, [][]float32{
+		{1, 2},
+		{3, 4},
+	})
+
+	swapped := NewDense(2, 2, [][]float32{{3, 4}, {1, 2}})
+	if !denseEqual(t, &swapped, SwapDenseRows(m, 0, 1)) {
+		t.Errorf("SwapDenseRows failed")
+	}
+
+	swappedCols := NewDense(2, 2, [][]float32{{2, 1}, {4, 3}})
+	if !denseEqual(t, &swappedCols, SwapDenseColumns(m, 0, 1)) {
+		t.Errorf("SwapDenseColumns failed")
+	}
+
+	scaled := NewDense(2, 2, [][]float32{{1, 2}, {1.5, 2}})
+	if !denseEqual(t, &scaled, MultiplyDenseRow(m, 1, 0.5)) {
+		t.Errorf("MultiplyDenseRow failed")
+	}
+
+	scaledCol := NewDense(2, 2, [][]float32{{1, -2}, {3, -4}})
+	if !denseEqual(t, &scaledCol, MultiplyDenseColumn(m, 1, -1)) {
+		t.Errorf("MultiplyDenseColumn failed")
+	}
+
+	added := NewDense(2, 2, [][]float32{{1, 2}, {0, -2}})
+	if !denseEqual(t, &added, AddScaledDenseRow(m, 1, 0, -3)) {
+		t.Errorf("AddScaledDenseRow failed")
+	}
+
+	addedCol := NewDense(2, 2, [][]float32{{1, 0}, {3, -2}})
+	if !denseEqual(t, &addedCol, AddScaledDenseColumn(m, 1, 0, -2)) {
+		t.Errorf("AddScaledDenseColumn failed")
+	}
+
+	// The original matrix is not modified and invalid indices are rejected
+	original := NewDense(2, 2, [][]float32{{1, 2}, {3, 4}})
+	if !denseEqual(t, &original, &m) {
+		t.Errorf("Dense row operations should not modify their input")
+	}
+	if SwapDenseRows(m, 0, 2) != nil || MultiplyDenseColumn(m, 2, 1) != nil || AddScaledDenseRow(m, -1, 0, 1) != nil {
+		t.Errorf("Dense row operations should return nil for out of bounds indices")
+	}
+}
+
+// TestDenseComplex tests arithmetic on complex matrices
+func TestDenseComplex(t *testing.T) {
+	m := NewDense(2, 2, [][]complex128{
+		{1i, 0},
+		{0, -1i},
+	})
+
+	expected := NewDense(2, 2, [][]complex128{
+		{-1, 0},
+		{0, -1},
+	})
+	if !denseEqual(t, &expected, MultiplyDense(m, m)) {
+		t.Errorf("MultiplyDense failed for complex matrices")
+	}
+
+	if result := AddScaledDenseRow(m, 0, 1, 1i); result.At(0, 1) != 1 {
+		t.Errorf("AddScaledDenseRow failed for complex matrices: got %v", result)
+	}
+}
+
+// TestDenseMatrixConversion tests DenseFromMatrix and MatrixFromDense
+func TestDenseMatrixConversion(t *testing.T) {
+	m := MustParse("[1 2; 3 4]")
+	d := DenseFromMatrix(m)
+
+	// Test case 1: Generic operations agree with the Matrix operations
+	product := MatrixFromDense(*MultiplyDense(d, d))
+	if !matricesEqual(t, MultiplyMatrices(m, m), &product) {
+		t.Errorf("MultiplyDense does not match MultiplyMatrices")
+	}
+
+	// Test case 2: The conversion copies the values
+	d.values[0][0] = 10
+	if m.values[0][0] != 1 {
+		t.Errorf("DenseFromMatrix should not share storage with the matrix")
+	}
+
+	// Test case 3: String
+	if expected := "⎡10  2⎤\n⎣ 3  4⎦"; d.String() != expected {
+		t.Errorf("Dense.String failed: expected\n%s\ngot\n%s", expected, d.String())
+	}
+}
@@ -0,0 +1,79 @@
+package matrix
+
+// ElementarySwap returns a pointer to the n × n elementary matrix that swaps rows row1 and row2 when multiplied on the left,
+// matching SwapRows.
+// Returns nil if either row index is out of bounds.
+func ElementarySwap(n, row1, row2 int) *Matrix {
+	return SwapRows(IdentityMatrix(n), row1, row2)
+}
+
+// ElementaryScale returns a pointer to the n × n elementary matrix that multiplies a row by a scalar when multiplied on the left,
+// matching MultiplyRow.
+// Returns nil if the row index is out of bounds or the scalar is zero, since the result would not be invertible.
+func ElementaryScale(n, row int, scalar float64) *Matrix {
+	if scalar == 0 {
+		return nil
+	}
+	return MultiplyRow(IdentityMatrix(n), row, scalar)
+}
+
+// ElementaryAddScaled returns a pointer to the n × n elementary matrix that adds a source row multiplied by a scalar
+// to a target row when multiplied on the left, matching AddScaledRow.
+// Returns nil if either row index is out of bounds or the rows are the same.
+func ElementaryAddScaled(n, targetRow, sourceRow int, scalar float64) *Matrix {
+	if targetRow == sourceRow {
+		return nil
+	}
+	return AddScaledRow(IdentityMatrix(n), targetRow, sourceRow, scalar)
+}
+
+// ElementaryMatrix returns a pointer to the n × n elementary matrix of a row operation.
+// Returns nil if the operation is not a valid elementary operation on n rows.
+func ElementaryMatrix(n int, op RowOperation) *Matrix {
+	switch op.Kind {
+	case RowSwap:
+		return ElementarySwap(n, op.Target, op.Source)
+	case RowScale:
+		return ElementaryScale(n, op.Target, op.Scalar)
+	case RowAddScaled:
+		return ElementaryAddScaled(n, op.Target, op.Source, op.Scalar)
+	}
+	return nil
+}
+
+// ApplyRowOperation applies a row operation to the matrix and returns a pointer to the resulting matrix.
+// Returns nil if the operation refers to rows that are out of bounds.
+func ApplyRowOperation(m Matrix, op RowOperation) *Matrix {
+	switch op.Kind {
+	case RowSwap:
+		return SwapRows(m, op.Target, op.Source)
+	case RowScale:
+		return MultiplyRow(m, op.Target, op.Scalar)
+	case RowAddScaled:
+		return AddScaledRow(m, op.Target, op.Source, op.Scalar)
+	}
+	return nil
+}
+
+// ReplayRowOperations applies a sequence of row operations to the matrix in order and returns a pointer to the resulting matrix.
+// It can be used to apply the operations recorded by a traced elimination to a right-hand side.
+// Returns nil if any operation refers to rows that are out of bounds.
+func ReplayRowOperations(m Matrix, ops []RowOperation) *Matrix {
+	result := Clone(m)
+	for _, op := range ops {
+		result = ApplyRowOperation(*result, op)
+		if result == nil {
+			return nil
+		}
+	}
+	return result
+}
+
+// StepOperations returns the row operations of a traced elimination in order.
+func StepOperations(steps []Step) []RowOperation {
+	ops := make([]RowOperation, len(steps))
+	for k, step := range steps {
+		ops[k] = step.Operation
+	}
+	return ops
+}
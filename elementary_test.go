@@ -0,0 +1,94 @@
+package matrix
+
+import (
+	"testing"
+)
+
+// TestElementaryMatrices tests the elementary matrix constructors
+func TestElementaryMatrices(t *testing.T) {
+	// Test case 1: Each elementary matrix has the expected entries
+	swap := MustParse("[0 0 1; 0 1 0; 1 0 0]")
+	if !matricesEqual(t, &swap, ElementarySwap(3, 0, 2)) {
+		t.Errorf("ElementarySwap failed for rows 0 and 2")
+	}
+
+	scale := MustParse("[1 0 0; 0 5 0; 0 0 1]")
+	if !matricesEqual(t, &scale, ElementaryScale(3, 1, 5)) {
+		t.Errorf("ElementaryScale failed for row 1")
+	}
+
+	add := MustParse("[1 0 0; 0 1 0; -4 0 1]")
+	if !matricesEqual(t, &add, ElementaryAddScaled(3, 2, 0, -4)) {
+		t.Errorf("ElementaryAddScaled failed for R3 ← R3 − 4·R1")
+	}
+
+	// Test case 2: Multiplying on the left matches the row operation
+	m := MustParse("[1 2; 3 4; 5 6]")
+	if !matricesEqual(t, AddScaledRow(m, 2, 0, -4), MultiplyMatrices(add, m)) {
+		t.Errorf("ElementaryAddScaled does not match AddScaledRow")
+	}
+
+	// Test case 3: Invalid operations
+	if ElementarySwap(3, 0, 3) != nil {
+		t.Errorf("ElementarySwap should return nil for an out of bounds row")
+	}
+	if ElementaryScale(3, 0, 0) != nil {
+		t.Errorf("ElementaryScale should return nil for a zero scalar")
+	}
+	if ElementaryAddScaled(3, 1, 1, 2) != nil {
+		t.Errorf("ElementaryAddScaled should return nil when the rows are the same")
+	}
+}
+
+// TestElementaryProductMatchesElimination tests that E_k…E_1·A equals the row echelon form of A
+func TestElementaryProductMatchesElimination(t *testing.T) {
+	a := MustParse("[0 2 4; 1 1 1; 2 6 9]")
+
+	for _, trace := range []func(Matrix) (*Matrix, []Step){RowEchelonFormTrace, ReducedRowEchelonFormTrace} {
+		result, steps := trace(a)
+
+		product := IdentityMatrix(3)
+		for _, step := range steps {
+			e := ElementaryMatrix(3, step.Operation)
+			if e == nil {
+				t.Fatalf("ElementaryMatrix returned nil for %v", step.Operation)
+			}
+			product = *MultiplyMatrices(*e, product)
+		}
+
+		if !matricesEqual(t, result, MultiplyMatrices(product, a)) {
+			t.Errorf("the product of the elementary matrices applied to A does not match the traced result")
+		}
+	}
+}
+
+// TestReplayRowOperations tests the ReplayRowOperations function
+func TestReplayRowOperations(t *testing.T) {
+	// Test case 1: Replaying the operations on the coefficients gives the traced result,
+	// and replaying them on the right-hand side gives the solution
+	a := MustParse("[2 1; 1 3]")
+	b := MustParse("[3; 5]")
+
+	result, steps := ReducedRowEchelonFormTrace(a)
+	ops := StepOperations(steps)
+
+	if !matricesEqual(t, result, ReplayRowOperations(a, ops)) {
+		t.Errorf("ReplayRowOperations does not reproduce the traced result")
+	}
+
+	expected := MustParse("[0.8; 1.4]")
+	x := ReplayRowOperations(b, ops)
+	for i := 0; i < 2; i++ {
+		if diff := x.values[i][0] - expected.values[i][0]; diff > 1e-12 || diff < -1e-12 {
+			t.Errorf("ReplayRowOperations gave the wrong solution: got %v", x.values)
+		}
+	}
+
+	// Test case 2: The original matrix is not modified and invalid operations are rejected
+	if a.values[0][0] != 2 {
+		t.Errorf("ReplayRowOperations should not modify its input")
+	}
+	if ReplayRowOperations(b, []RowOperation{{Kind: RowSwap, Target: 0, Source: 2}}) != nil {
+		t.Errorf("ReplayRowOperations should return nil for an out of bounds row")
+	}
+}
@@ -0,0 +1,156 @@
+package matrix
+
+import (
+	"math/rand/v2"
+)
+
+// exerciseMaxDenominator is the largest denominator GenerateExercise snaps the answer key and step matrices to.
+const exerciseMaxDenominator = 1000
+
+// exerciseAttempts is the number of random row mixings GenerateExercise tries before giving up.
+const exerciseAttempts = 100
+
+// ExerciseOptions configures GenerateExercise.
+type ExerciseOptions struct {
+	// Rows and Columns are the number of equations and unknowns.
+	Rows    int
+	Columns int
+	// Rank is the rank of the coefficient matrix, at most the smaller of Rows and Columns.
+	Rank int
+	// Inconsistent asks for a system with no solution, which requires Rank to be less than Rows.
+	Inconsistent bool
+	// Bound limits the size of the integers used to build the system. Zero means 3.
+	Bound int
+	// Denominator, if greater than one, makes the particular solution a multiple of 1/Denominator
+	// instead of an integer.
+	Denominator int
+}
+
+// Exercise is a linear system A·x = b with integer coefficients together with its answer key.
+type Exercise struct {
+	// Coefficients is the Rows × Columns matrix A, Constants is the Rows × 1 column b,
+	// and Augmented is [A | b].
+	Coefficients Matrix
+	Constants    Matrix
+	Augmented    Matrix
+	// Consistent reports whether the system has a solution.
+	Consistent bool
+	// PivotColumns lists the columns of A that hold a pivot; the other unknowns are free.
+	PivotColumns []int
+	// Solution is the reduced row echelon form of the augmented matrix, and Steps are the row operations
+	// that produce it, as returned by ReducedRowEchelonFormTrace with the snapshots snapped to fractions.
+	Solution Matrix
+	Steps    []Step
+}
+
+// GenerateExercise generates a random linear system with small integer coefficients whose reduced row echelon form
+// has integer elements, apart from the solution column when opts.Denominator is greater than one, and returns a pointer to it.
+// The system is built backwards from its answer: a random reduced row echelon form R with integer elements,
+// each row scaled by Denominator if needed to clear the fractions, is multiplied by a random unimodular matrix,
+// which mixes the rows while keeping every element an integer and leaving the reduced row echelon form unchanged.
+// The answer key is computed by ReducedRowEchelonFormTrace, with values that are within rounding of a fraction snapped to it,
+// and checked against the reduced row echelon form the system was built from; if rounding leads the elimination astray,
+// the rows are mixed again.
+// Returns nil if the dimensions or rank are invalid, if an inconsistent system is requested with a rank equal to Rows,
+// or if no mixing gives an exact answer key within a fixed number of attempts.
+func GenerateExercise(rng *rand.Rand, opts ExerciseOptions) *Exercise {
+	// Check if the options are valid
+	if opts.Rows < 1 || opts.Columns < 1 || opts.Rank < 0 || opts.Rank > min(opts.Rows, opts.Columns) {
+		return nil
+	}
+	if opts.Inconsistent && opts.Rank == opts.Rows {
+		return nil
+	}
+	if opts.Bound < 0 || opts.Denominator < 0 {
+		return nil
+	}
+	bound := opts.Bound
+	if bound == 0 {
+		bound = 3
+	}
+	denominator := max(opts.Denominator, 1)
+
+	// Choose the pivot columns as a random increasing subset of the columns
+	pivots := rng.Perm(opts.Columns)[:opts.Rank]
+	isPivot := make([]bool, opts.Columns)
+	for _, col := range pivots {
+		isPivot[col] = true
+	}
+	pivots = pivots[:0]
+	for col := range isPivot {
+		if isPivot[col] {
+			pivots = append(pivots, col)
+		}
+	}
+
+	// Build the target reduced row echelon form of the augmented matrix, scaled to integers.
+	// Row i has its pivot in column pivots[i], random integers in the free columns to its right,
+	// and a solution value of k/denominator, so the whole row is multiplied by denominator.
+	target := zeroMatrix(opts.Rows, opts.Columns+1)
+	for i, col := range pivots {
+		target.values[i][col] = float64(denominator)
+		for j := col + 1; j < opts.Columns; j++ {
+			if !isPivot[j] {
+				target.values[i][j] = float64(denominator * (rng.IntN(2*bound+1) - bound))
+			}
+		}
+		target.values[i][opts.Columns] = float64(rng.IntN(2*bound*denominator+1) - bound*denominator)
+	}
+
+	// An inconsistent system has an extra pivot in the constants column: the equation 0 = 1
+	if opts.Inconsistent {
+		target.values[opts.Rank][opts.Columns] = 1
+	}
+
+	// The exact reduced row echelon form is the target with each row divided by its scale,
+	// except that the pivot of 0 = 1 clears the rest of the constants column
+	exact := *Clone(target)
+	for i := range pivots {
+		for j := 0; j <= opts.Columns; j++ {
+			exact.values[i][j] = target.values[i][j] / float64(denominator)
+		}
+		if opts.Inconsistent {
+			exact.values[i][opts.Columns] = 0
+		}
+	}
+
+	for attempt := 0; attempt < exerciseAttempts; attempt++ {
+		// Mix the rows with a unimodular matrix, which keeps the elements integers and the row space the same
+		augmented := *MultiplyMatrices(*RandomUnimodular(rng, opts.Rows, 1), target)
+
+		// Floating point elimination can mistake a rounding residue for a pivot; try another mixing if it does
+		solution, steps := ReducedRowEchelonFormTrace(augmented)
+		if !Equal(snapToFractions(*solution), exact) {
+			continue
+		}
+		for i := range steps {
+			steps[i].Matrix = snapToFractions(steps[i].Matrix)
+		}
+
+		return &Exercise{
+			Coefficients: *Clone(*Slice(augmented, 0, opts.Rows, 0, opts.Columns)),
+			Constants:    *Clone(*Slice(augmented, 0, opts.Rows, opts.Columns, opts.Columns+1)),
+			Augmented:    augmented,
+			Consistent:   !opts.Inconsistent,
+			PivotColumns: pivots,
+			Solution:     exact,
+			Steps:        steps,
+		}
+	}
+
+	return nil
+}
+
+// snapToFractions returns a copy of the matrix in which every element that is within rounding of a fraction
+// with a denominator of at most exerciseMaxDenominator is replaced by the nearest float64 to that fraction.
+func snapToFractions(m Matrix) Matrix {
+	result := *Clone(m)
+	for i := 0; i < result.rows; i++ {
+		for j := 0; j < result.columns; j++ {
+			if num, den, ok := fraction(result.values[i][j], exerciseMaxDenominator); ok {
+				result.values[i][j] = float64(num) / float64(den)
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,105 @@
+package matrix
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+// TestGenerateExercise tests the GenerateExercise function
+func TestGenerateExercise(t *testing.T) {
+	rng := newTestRand(7)
+	options := []ExerciseOptions{
+		{Rows: 3, Columns: 3, Rank: 3},
+		{Rows: 3, Columns: 4, Rank: 2},
+		{Rows: 4, Columns: 3, Rank: 2, Inconsistent: true},
+		{Rows: 3, Columns: 3, Rank: 3, Denominator: 4},
+		{Rows: 5, Columns: 5, Rank: 4, Bound: 5, Denominator: 3},
+		{Rows: 2, Columns: 2, Rank: 0},
+	}
+
+	for n, opts := range options {
+		for trial := 0; trial < 10; trial++ {
+			e := GenerateExercise(rng, opts)
+			if e == nil {
+				t.Fatalf("Options %d: GenerateExercise returned nil", n)
+			}
+
+			// Test case 1: The system has integer elements and the requested shape
+			if e.Coefficients.rows != opts.Rows || e.Coefficients.columns != opts.Columns || e.Constants.columns != 1 {
+				t.Fatalf("Options %d: GenerateExercise produced a %dx%d system", n, e.Coefficients.rows, e.Coefficients.columns)
+			}
+			if !Equal(e.Augmented, *HStack(e.Coefficients, e.Constants)) {
+				t.Errorf("Options %d: Augmented is not [Coefficients | Constants]", n)
+			}
+			for _, v := range Flatten(e.Augmented, RowMajor) {
+				if v != math.Trunc(v) {
+					t.Fatalf("Options %d: GenerateExercise produced the non-integer element %v", n, v)
+				}
+			}
+
+			// Test case 2: The answer key is the exact reduced row echelon form
+			exact := MatrixFromRatMatrix(*RatReducedRowEchelonForm(*RatMatrixFromMatrix(e.Augmented)))
+			if !matricesEqual(t, &exact, &e.Solution) {
+				t.Fatalf("Options %d: the answer key is not the exact reduced row echelon form", n)
+			}
+
+			// Test case 3: The answer key has the requested pivots, consistency and denominators
+			var pivots []int
+			consistent := true
+			for i := 0; i < e.Solution.rows; i++ {
+				lead := leadingColumn(e.Solution.values[i], 0)
+				if lead == opts.Columns {
+					consistent = false
+				} else if lead != -1 {
+					pivots = append(pivots, lead)
+				}
+			}
+			if !slices.Equal(pivots, e.PivotColumns) || len(pivots) != opts.Rank {
+				t.Errorf("Options %d: expected %d pivots at %v, got %v", n, opts.Rank, e.PivotColumns, pivots)
+			}
+			if consistent != e.Consistent || consistent == opts.Inconsistent {
+				t.Errorf("Options %d: expected a consistent system %v, got %v", n, !opts.Inconsistent, consistent)
+			}
+
+			// Coefficients in the answer key are integers and solution values are multiples of 1/Denominator
+			scale := float64(max(opts.Denominator, 1))
+			for i := 0; i < e.Solution.rows; i++ {
+				for j := 0; j < opts.Columns; j++ {
+					if v := e.Solution.values[i][j]; v != math.Trunc(v) {
+						t.Fatalf("Options %d: the answer key has the non-integer coefficient %v", n, v)
+					}
+				}
+				if v := e.Solution.values[i][opts.Columns] * scale; math.Abs(v-math.Round(v)) > 1e-9 {
+					t.Fatalf("Options %d: the answer key has the solution value %v", n, e.Solution.values[i][opts.Columns])
+				}
+			}
+
+			// Test case 4: Replaying the steps reproduces the answer key
+			replayed := ReplayRowOperations(e.Augmented, StepOperations(e.Steps))
+			if !EqualApprox(*replayed, e.Solution, 1e-9, 0) {
+				t.Errorf("Options %d: replaying the steps does not reproduce the answer key", n)
+			}
+			if len(e.Steps) > 0 && !Equal(e.Steps[len(e.Steps)-1].Matrix, e.Solution) {
+				t.Errorf("Options %d: the last step does not match the answer key", n)
+			}
+		}
+	}
+}
+
+// TestGenerateExerciseInvalid tests that GenerateExercise rejects invalid options
+func TestGenerateExerciseInvalid(t *testing.T) {
+	rng := newTestRand(8)
+	invalid := []ExerciseOptions{
+		{Rows: 0, Columns: 3, Rank: 0},
+		{Rows: 3, Columns: 2, Rank: 3},
+		{Rows: 3, Columns: 3, Rank: -1},
+		{Rows: 3, Columns: 4, Rank: 3, Inconsistent: true},
+		{Rows: 3, Columns: 3, Rank: 2, Bound: -1},
+	}
+	for i, opts := range invalid {
+		if GenerateExercise(rng, opts) != nil {
+			t.Errorf("GenerateExercise should return nil for invalid options %d: %+v", i, opts)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package matrix
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// elideThreshold is the number of rows or columns above which the middle of the matrix is elided when printing.
+	elideThreshold = 16
+	// elideEdgeItems is the number of rows or columns kept at each end of an elided dimension.
+	elideEdgeItems = 3
+)
+
+// String returns the matrix rendered with aligned columns, as produced by the %v verb.
+func (m Matrix) String() string {
+	return fmt.Sprintf("%v", m)
+}
+
+// Format implements fmt.Formatter.
+// The verbs %v, %s, %g, %G, %e, %E, %f and %F render the matrix with aligned columns and bracket borders,
+// honoring the width, precision and '+' flags of the verb. %v and %s use the shortest representation of each value.
+// Large matrices have their middle rows and columns elided; %+v and %+s print a dimension header and every element.
+func (m Matrix) Format(s fmt.State, verb rune) {
+	if verb == 's' {
+		verb = 'v'
+	}
+
+	// Map the verb to a strconv format
+	var format byte
+	switch verb {
+	case 'v', 'g':
+		format = 'g'
+	case 'G', 'e', 'E', 'f', 'F':
+		format = byte(verb)
+		if verb == 'F' {
+			format = 'f'
+		}
+	default:
+		fmt.Fprintf(s, "%%!%c(matrix.Matrix=%dx%d)", verb, m.rows, m.columns)
+		return
+	}
+
+	precision, ok := s.Precision()
+	if !ok {
+		precision = -1
+		if format != 'g' && format != 'G' {
+			precision = 6
+		}
+	}
+	width, _ := s.Width()
+	plus := s.Flag('+')
+
+	// %+v shows the dimensions and never elides
+	elide := true
+	if verb == 'v' && plus {
+		fmt.Fprintf(s, "%dx%d\n", m.rows, m.columns)
+		elide = false
+		plus = false
+	}
+
+	fmt.Fprint(s, renderMatrix(m.rows, m.columns, func(i, j int) string {
+		text := strconv.FormatFloat(m.values[i][j], format, precision, 64)
+		if plus && !strings.HasPrefix(text, "-") && !strings.HasPrefix(text, "+") {
+			text = "+" + text
+		}
+		return text
+	}, width, elide))
+}
+
+// visibleIndices returns the indices of the items to show out of n. That is all of them, or, if elide is true
+// and n exceeds elideThreshold, the first and last elideEdgeItems with a -1 between them marking the elided items.
+func visibleIndices(n int, elide bool) []int {
+	if !elide || n <= elideThreshold {
+		indices := make([]int, n)
+		for i := range indices {
+			indices[i] = i
+		}
+		return indices
+	}
+
+	indices := make([]int, 0, 2*elideEdgeItems+1)
+	for i := 0; i < elideEdgeItems; i++ {
+		indices = append(indices, i)
+	}
+	indices = append(indices, -1)
+	for i := n - elideEdgeItems; i < n; i++ {
+		indices = append(indices, i)
+	}
+	return indices
+}
+
+// renderMatrix lays out the elements of a rows × columns matrix, as formatted by cell,
+// in right-aligned columns between bracket borders.
+func renderMatrix(rows, columns int, cell func(i, j int) string, minWidth int, elide bool) string {
+	if rows == 0 || columns == 0 {
+		return "[]"
+	}
+
+	rowIndices := visibleIndices(rows, elide)
+	colIndices := visibleIndices(columns, elide)
+
+	// Format every visible cell and measure the width of each column
+	cells := make([][]string, len(rowIndices))
+	widths := make([]int, len(colIndices))
+	for i, r := range rowIndices {
+		cells[i] = make([]string, len(colIndices))
+		for j, c := range colIndices {
+			switch {
+			case r < 0 && c < 0:
+				cells[i][j] = "⋱"
+			case r < 0:
+				cells[i][j] = "⋮"
+			case c < 0:
+				cells[i][j] = "…"
+			default:
+				cells[i][j] = cell(r, c)
+			}
+			widths[j] = max(widths[j], utf8.RuneCountInString(cells[i][j]), minWidth)
+		}
+	}
+
+	// Write each row with the bracket pieces for its position
+	var b strings.Builder
+	for i := range cells {
+		left, right := "⎢", "⎥"
+		switch {
+		case len(cells) == 1:
+			left, right = "[", "]"
+		case i == 0:
+			left, right = "⎡", "⎤"
+		case i == len(cells)-1:
+			left, right = "⎣", "⎦"
+		}
+
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		b.WriteString(left)
+		for j, cell := range cells[i] {
+			if j > 0 {
+				b.WriteString("  ")
+			}
+			b.WriteString(strings.Repeat(" ", widths[j]-utf8.RuneCountInString(cell)))
+			b.WriteString(cell)
+		}
+		b.WriteString(right)
+	}
+
+	return b.String()
+}
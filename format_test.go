@@ -0,0 +1,109 @@
+package matrix
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// TestString tests the String method
+func TestString(t *testing.T) {
+	// Test case 1: Aligned columns with bracket borders
+	m1 := NewMatrix(3, 2, [][]float64{
+		{1, -2.5},
+		{30, 4},
+		{5, 6},
+	})
+
+	expected1 := "⎡ 1  -2.5⎤\n" +
+		"⎢30     4⎥\n" +
+		"⎣ 5     6⎦"
+
+	if result1 := m1.String(); result1 != expected1 {
+		t.Errorf("String failed for 3x2 matrix: expected\n%s\ngot\n%s", expected1, result1)
+	}
+
+	// Test case 2: A single row uses square brackets
+	m2 := NewMatrix(1, 3, [][]float64{{1, 2, 3}})
+	if result2 := m2.String(); result2 != "[1  2  3]" {
+		t.Errorf("String failed for 1x3 matrix: got %q", result2)
+	}
+
+	// Test case 3: An empty matrix
+	m3 := NewMatrix(0, 0, nil)
+	if result3 := m3.String(); result3 != "[]" {
+		t.Errorf("String failed for empty matrix: got %q", result3)
+	}
+}
+
+// TestFormat tests the Format method
+func TestFormat(t *testing.T) {
+	m := NewMatrix(2, 2, [][]float64{
+		{1, 2},
+		{3, 4.25},
+	})
+
+	// Test case 1: Precision with %f
+	expected1 := "⎡1.000  2.000⎤\n⎣3.000  4.250⎦"
+	if result1 := fmt.Sprintf("%.3f", m); result1 != expected1 {
+		t.Errorf("Format failed for %%.3f: expected\n%s\ngot\n%s", expected1, result1)
+	}
+
+	// Test case 2: Scientific notation with %e
+	expected2 := "⎡1.0e+00  2.0e+00⎤\n⎣3.0e+00  4.2e+00⎦"
+	if result2 := fmt.Sprintf("%.1e", m); result2 != expected2 {
+		t.Errorf("Format failed for %%.1e: expected\n%s\ngot\n%s", expected2, result2)
+	}
+
+	// Test case 3: %+v prints the dimensions
+	expected3 := "2x2\n⎡1     2⎤\n⎣3  4.25⎦"
+	if result3 := fmt.Sprintf("%+v", m); result3 != expected3 {
+		t.Errorf("Format failed for %%+v: expected\n%s\ngot\n%s", expected3, result3)
+	}
+
+	// Test case 4: The '+' flag with other verbs shows signs
+	expected4 := "⎡+1  +2⎤\n⎣+3  +4⎦"
+	if result4 := fmt.Sprintf("%+.0f", m); result4 != expected4 {
+		t.Errorf("Format failed for %%+.0f: expected\n%s\ngot\n%s", expected4, result4)
+	}
+
+	// Test case 5: %s prints the same as %v and String
+	if result5 := fmt.Sprintf("%s", m); result5 != m.String() || result5 != fmt.Sprintf("%v", m) {
+		t.Errorf("Format failed for %%s: expected\n%s\ngot\n%s", m.String(), result5)
+	}
+
+	// Test case 6: Unsupported verb
+	if result6 := fmt.Sprintf("%d", m); result6 != "%!d(matrix.Matrix=2x2)" {
+		t.Errorf("Format failed for unsupported verb: got %q", result6)
+	}
+}
+
+// TestFormatElision tests that large matrices are elided when printed
+func TestFormatElision(t *testing.T) {
+	values := make([][]float64, 20)
+	for i := range values {
+		values[i] = make([]float64, 20)
+		for j := range values[i] {
+			values[i][j] = float64(i*20 + j)
+		}
+	}
+	m := NewMatrix(20, 20, values)
+
+	// Test case 1: %v elides the middle rows and columns
+	lines := strings.Split(fmt.Sprintf("%v", m), "\n")
+	if len(lines) != 2*elideEdgeItems+1 {
+		t.Fatalf("Format should print %d rows for an elided matrix, got %d", 2*elideEdgeItems+1, len(lines))
+	}
+	if !strings.Contains(lines[0], "…") || !strings.Contains(lines[elideEdgeItems], "⋮") {
+		t.Errorf("Format should mark elided rows and columns, got\n%s", strings.Join(lines, "\n"))
+	}
+	if !strings.HasSuffix(lines[len(lines)-1], "399⎦") {
+		t.Errorf("Format should keep the last element, got %q", lines[len(lines)-1])
+	}
+
+	// Test case 2: %+v prints every row
+	lines = strings.Split(fmt.Sprintf("%+v", m), "\n")
+	if len(lines) != 21 {
+		t.Errorf("Format with %%+v should print a header and 20 rows, got %d lines", len(lines))
+	}
+}
@@ -0,0 +1,197 @@
+package matrix
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// JSONLayout selects how the data of a matrix is laid out in its JSON encoding.
+type JSONLayout int
+
+const (
+	// JSONNested encodes the data as an array of rows: {"rows":2,"columns":2,"data":[[1,2],[3,4]]}.
+	JSONNested JSONLayout = iota
+	// JSONFlat encodes the data as a single row-major array: {"rows":2,"columns":2,"layout":"flat","data":[1,2,3,4]}.
+	JSONFlat
+)
+
+// jsonMatrix is the wire representation of a Matrix.
+type jsonMatrix struct {
+	Rows    int             `json:"rows"`
+	Columns int             `json:"columns"`
+	Layout  string          `json:"layout,omitempty"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// jsonFloat is a float64 that encodes NaN and ±Inf as the strings "NaN", "+Inf" and "-Inf",
+// since JSON numbers cannot represent them.
+type jsonFloat float64
+
+// MarshalJSON implements json.Marshaler.
+func (f jsonFloat) MarshalJSON() ([]byte, error) {
+	v := float64(f)
+	switch {
+	case math.IsNaN(v):
+		return []byte(`"NaN"`), nil
+	case math.IsInf(v, 1):
+		return []byte(`"+Inf"`), nil
+	case math.IsInf(v, -1):
+		return []byte(`"-Inf"`), nil
+	}
+	return strconv.AppendFloat(nil, v, 'g', -1, 64), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *jsonFloat) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		switch s {
+		case "NaN":
+			*f = jsonFloat(math.NaN())
+		case "+Inf", "Inf":
+			*f = jsonFloat(math.Inf(1))
+		case "-Inf":
+			*f = jsonFloat(math.Inf(-1))
+		default:
+			return fmt.Errorf("matrix: invalid JSON value %q", s)
+		}
+		return nil
+	}
+
+	var v float64
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*f = jsonFloat(v)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler using the nested layout.
+func (m Matrix) MarshalJSON() ([]byte, error) {
+	return MarshalJSONLayout(m, JSONNested)
+}
+
+// MarshalJSONLayout encodes the matrix as JSON with the data in the given layout.
+// It returns an error for a matrix with no columns and more than MaxEmptyRows rows, which UnmarshalJSON would reject.
+func MarshalJSONLayout(m Matrix, layout JSONLayout) ([]byte, error) {
+	// Check if the decoder would accept the shape
+	if tooManyEmptyRows(m.rows, m.columns) {
+		return nil, fmt.Errorf("matrix: %dx0 matrix exceeds the limit of %d rows without columns", m.rows, MaxEmptyRows)
+	}
+
+	out := jsonMatrix{
+		Rows:    m.rows,
+		Columns: m.columns,
+	}
+
+	var data any
+	switch layout {
+	case JSONNested:
+		rows := make([][]jsonFloat, m.rows)
+		for i := 0; i < m.rows; i++ {
+			rows[i] = make([]jsonFloat, m.columns)
+			for j := 0; j < m.columns; j++ {
+				rows[i][j] = jsonFloat(m.values[i][j])
+			}
+		}
+		data = rows
+	case JSONFlat:
+		out.Layout = "flat"
+		flat := make([]jsonFloat, 0, m.rows*m.columns)
+		for i := 0; i < m.rows; i++ {
+			for j := 0; j < m.columns; j++ {
+				flat = append(flat, jsonFloat(m.values[i][j]))
+			}
+		}
+		data = flat
+	default:
+		return nil, fmt.Errorf("matrix: unknown JSON layout %d", layout)
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	out.Data = raw
+
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts both the nested and the flat layout
+// and returns an error if the data doesn't match the declared shape, or if the shape has no columns
+// and more than MaxEmptyRows rows.
+func (m *Matrix) UnmarshalJSON(data []byte) error {
+	var in jsonMatrix
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+
+	// Check if the declared shape is valid
+	if in.Rows < 0 || in.Columns < 0 {
+		return fmt.Errorf("matrix: invalid JSON shape %dx%d", in.Rows, in.Columns)
+	}
+	if tooManyEmptyRows(in.Rows, in.Columns) {
+		return fmt.Errorf("matrix: JSON shape %dx0 exceeds the limit of %d rows without columns", in.Rows, MaxEmptyRows)
+	}
+	if in.Data == nil {
+		return fmt.Errorf("matrix: JSON data is missing")
+	}
+
+	// Decode the data and check it against the declared shape before allocating the matrix
+	var values [][]float64
+	switch in.Layout {
+	case "", "nested":
+		var rows [][]jsonFloat
+		if err := json.Unmarshal(in.Data, &rows); err != nil {
+			return err
+		}
+		if len(rows) != in.Rows {
+			return fmt.Errorf("matrix: JSON data has %d rows, expected %d", len(rows), in.Rows)
+		}
+		for i, row := range rows {
+			if len(row) != in.Columns {
+				return fmt.Errorf("matrix: JSON row %d has %d columns, expected %d", i, len(row), in.Columns)
+			}
+		}
+		values = make([][]float64, in.Rows)
+		for i, row := range rows {
+			values[i] = make([]float64, in.Columns)
+			for j, v := range row {
+				values[i][j] = float64(v)
+			}
+		}
+	case "flat":
+		var flat []jsonFloat
+		if err := json.Unmarshal(in.Data, &flat); err != nil {
+			return err
+		}
+		if in.Columns == 0 {
+			if len(flat) != 0 {
+				return fmt.Errorf("matrix: JSON data has %d elements, expected 0", len(flat))
+			}
+		} else if in.Rows > len(flat)/in.Columns || in.Rows*in.Columns != len(flat) {
+			return fmt.Errorf("matrix: JSON data has %d elements, expected %dx%d", len(flat), in.Rows, in.Columns)
+		}
+		values = make([][]float64, in.Rows)
+		for i := 0; i < in.Rows; i++ {
+			values[i] = make([]float64, in.Columns)
+			for j := 0; j < in.Columns; j++ {
+				values[i][j] = float64(flat[i*in.Columns+j])
+			}
+		}
+	default:
+		return fmt.Errorf("matrix: unknown JSON layout %q", in.Layout)
+	}
+
+	*m = Matrix{
+		rows:    in.Rows,
+		columns: in.Columns,
+		values:  values,
+	}
+	return nil
+}
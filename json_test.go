@@ -0,0 +1,135 @@
+package matrix
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+// TestMarshalJSON tests the MarshalJSON method
+func TestMarshalJSON(t *testing.T) {
+	// Test case 1: Nested layout
+	m1 := NewMatrix(2, 3, [][]float64{
+		{1, 2, 3},
+		{4.5, 5, 6},
+	})
+
+	expected1 := `{"rows":2,"columns":3,"data":[[1,2,3],[4.5,5,6]]}`
+	result1, err := json.Marshal(m1)
+	if err != nil {
+		t.Fatalf("MarshalJSON returned an error: %v", err)
+	}
+	if string(result1) != expected1 {
+		t.Errorf("MarshalJSON failed: expected %s, got %s", expected1, result1)
+	}
+
+	// Test case 2: Flat layout
+	expected2 := `{"rows":2,"columns":3,"layout":"flat","data":[1,2,3,4.5,5,6]}`
+	result2, err := MarshalJSONLayout(m1, JSONFlat)
+	if err != nil {
+		t.Fatalf("MarshalJSONLayout returned an error: %v", err)
+	}
+	if string(result2) != expected2 {
+		t.Errorf("MarshalJSONLayout failed: expected %s, got %s", expected2, result2)
+	}
+
+	// Test case 3: Non-finite values are encoded as strings
+	m3 := NewMatrix(1, 3, [][]float64{{math.NaN(), math.Inf(1), math.Inf(-1)}})
+	expected3 := `{"rows":1,"columns":3,"data":[["NaN","+Inf","-Inf"]]}`
+	result3, err := json.Marshal(m3)
+	if err != nil {
+		t.Fatalf("MarshalJSON returned an error: %v", err)
+	}
+	if string(result3) != expected3 {
+		t.Errorf("MarshalJSON failed for non-finite values: expected %s, got %s", expected3, result3)
+	}
+}
+
+// TestUnmarshalJSON tests the UnmarshalJSON method
+func TestUnmarshalJSON(t *testing.T) {
+	expected := &Matrix{
+		rows:    2,
+		columns: 2,
+		values: [][]float64{
+			{1, 2},
+			{3, 4},
+		},
+	}
+
+	// Test case 1: Nested and flat layouts decode to the same matrix
+	var nested, flat Matrix
+	if err := json.Unmarshal([]byte(`{"rows":2,"columns":2,"data":[[1,2],[3,4]]}`), &nested); err != nil {
+		t.Fatalf("UnmarshalJSON returned an error for nested layout: %v", err)
+	}
+	if !matricesEqual(t, expected, &nested) {
+		t.Errorf("UnmarshalJSON failed for nested layout")
+	}
+	if err := json.Unmarshal([]byte(`{"rows":2,"columns":2,"layout":"flat","data":[1,2,3,4]}`), &flat); err != nil {
+		t.Fatalf("UnmarshalJSON returned an error for flat layout: %v", err)
+	}
+	if !matricesEqual(t, expected, &flat) {
+		t.Errorf("UnmarshalJSON failed for flat layout")
+	}
+
+	// Test case 2: Round trip with non-finite values
+	m2 := NewMatrix(1, 3, [][]float64{{math.NaN(), math.Inf(1), -0.125}})
+	data, err := json.Marshal(m2)
+	if err != nil {
+		t.Fatalf("MarshalJSON returned an error: %v", err)
+	}
+	var result2 Matrix
+	if err := json.Unmarshal(data, &result2); err != nil {
+		t.Fatalf("UnmarshalJSON returned an error: %v", err)
+	}
+	if !math.IsNaN(result2.values[0][0]) || !math.IsInf(result2.values[0][1], 1) || result2.values[0][2] != -0.125 {
+		t.Errorf("UnmarshalJSON round trip failed: got %v", result2.values)
+	}
+
+	// Test case 3: Invalid inputs
+	invalid := []string{
+		`{"rows":2,"columns":2,"data":[[1,2]]}`,
+		`{"rows":2,"columns":2,"data":[[1,2],[3]]}`,
+		`{"rows":2,"columns":2,"layout":"flat","data":[1,2,3]}`,
+		`{"rows":1,"columns":1,"layout":"diagonal","data":[1]}`,
+		`{"rows":-1,"columns":1,"data":[]}`,
+		`{"rows":1,"columns":1}`,
+		`{"rows":1,"columns":1,"data":[["Infinity"]]}`,
+		`{"rows":4611686018427387904,"columns":0,"data":[]}`,
+		`{"rows":4611686018427387904,"columns":0,"layout":"flat","data":[]}`,
+		`{"rows":4294967296,"columns":4294967296,"layout":"flat","data":[]}`,
+		`{"rows":2,"columns":0,"layout":"flat","data":[1]}`,
+	}
+	for _, input := range invalid {
+		var m Matrix
+		if err := json.Unmarshal([]byte(input), &m); err == nil {
+			t.Errorf("UnmarshalJSON should return an error for %s", input)
+		}
+	}
+
+	// Test case 4: Matrices with no columns round-trip in both layouts up to MaxEmptyRows rows
+	empty := zeroMatrix(2000000, 0)
+	tooMany := Matrix{rows: MaxEmptyRows + 1}
+	for _, layout := range []JSONLayout{JSONNested, JSONFlat} {
+		data, err := MarshalJSONLayout(empty, layout)
+		if err != nil {
+			t.Fatalf("MarshalJSONLayout returned an error for a 2000000x0 matrix: %v", err)
+		}
+		var result Matrix
+		if err := json.Unmarshal(data, &result); err != nil || result.rows != 2000000 || result.columns != 0 {
+			t.Errorf("UnmarshalJSON failed to round-trip a 2000000x0 matrix in layout %d: %v", layout, err)
+		}
+
+		if _, err := MarshalJSONLayout(tooMany, layout); err == nil {
+			t.Errorf("MarshalJSONLayout should return an error for more than MaxEmptyRows rows in layout %d", layout)
+		}
+	}
+	for _, layout := range []string{"nested", "flat"} {
+		input := fmt.Sprintf(`{"rows":%d,"columns":0,"layout":%q,"data":[]}`, MaxEmptyRows+1, layout)
+		var result Matrix
+		if err := json.Unmarshal([]byte(input), &result); err == nil || !strings.Contains(err.Error(), "limit") {
+			t.Errorf("UnmarshalJSON should report the row limit for %s, got %v", input, err)
+		}
+	}
+}
@@ -23,6 +23,48 @@ func NewMatrix(rows, cols int, values [][]float64) Matrix {
 	return m
 }
 
+// IdentityMatrix returns the n × n identity matrix.
+func IdentityMatrix(n int) Matrix {
+	m := Matrix{
+		rows:    n,
+		columns: n,
+		values:  make([][]float64, n),
+	}
+
+	for i := 0; i < n; i++ {
+		m.values[i] = make([]float64, n)
+		m.values[i][i] = 1
+	}
+
+	return m
+}
+
+// MaxEmptyRows is the largest number of rows a matrix with no columns may have in the binary, JSON and npy encodings.
+// Such a matrix has no elements to bound its size in the encoded data, yet every row still costs a slice header,
+// so the decoders reject larger row counts rather than let a corrupted header force a huge allocation,
+// and the encoders refuse to write what the decoders would reject.
+const MaxEmptyRows = 1 << 22
+
+// tooManyEmptyRows reports whether a rows × cols shape has no columns and more than MaxEmptyRows rows.
+func tooManyEmptyRows(rows, cols int) bool {
+	return cols == 0 && rows > MaxEmptyRows
+}
+
+// zeroMatrix returns a rows × cols matrix of zeros.
+func zeroMatrix(rows, cols int) Matrix {
+	m := Matrix{
+		rows:    rows,
+		columns: cols,
+		values:  make([][]float64, rows),
+	}
+
+	for i := 0; i < rows; i++ {
+		m.values[i] = make([]float64, cols)
+	}
+
+	return m
+}
+
 // AddMatrices adds two matrices and returns a pointer to the resulting matrix.
 // Returns nil if the matrices have different dimensions.
 func AddMatrices(a, b Matrix) *Matrix {
@@ -168,6 +210,208 @@ func AppendColumn(m Matrix, column []float64) *Matrix {
 	return result
 }
 
+// InsertRow inserts a new row before the row at index idx and returns a pointer to the resulting matrix.
+// An index equal to the number of rows appends the row at the end.
+// Returns nil if the index is out of bounds or the length of the new row doesn't match the number of columns.
+func InsertRow(m Matrix, idx int, row []float64) *Matrix {
+	// Check if the index and the length of the new row are valid
+	if idx < 0 || idx > m.rows || len(row) != m.columns {
+		return nil
+	}
+
+	// Create a new matrix with dimensions (m.rows + 1) × m.columns
+	result := &Matrix{
+		rows:    m.rows + 1,
+		columns: m.columns,
+		values:  make([][]float64, m.rows+1),
+	}
+
+	// Copy values from the original matrix, placing the new row at idx
+	for i := 0; i < result.rows; i++ {
+		result.values[i] = make([]float64, m.columns)
+		switch {
+		case i < idx:
+			copy(result.values[i], m.values[i])
+		case i == idx:
+			copy(result.values[i], row)
+		default:
+			copy(result.values[i], m.values[i-1])
+		}
+	}
+
+	return result
+}
+
+// InsertColumn inserts a new column before the column at index idx and returns a pointer to the resulting matrix.
+// An index equal to the number of columns appends the column at the end.
+// Returns nil if the index is out of bounds or the length of the new column doesn't match the number of rows.
+func InsertColumn(m Matrix, idx int, column []float64) *Matrix {
+	// Check if the index and the length of the new column are valid
+	if idx < 0 || idx > m.columns || len(column) != m.rows {
+		return nil
+	}
+
+	// Create a new matrix with dimensions m.rows × (m.columns + 1)
+	result := &Matrix{
+		rows:    m.rows,
+		columns: m.columns + 1,
+		values:  make([][]float64, m.rows),
+	}
+
+	// Copy values from the original matrix, placing the new column at idx
+	for i := 0; i < m.rows; i++ {
+		result.values[i] = make([]float64, m.columns+1)
+		copy(result.values[i][:idx], m.values[i][:idx])
+		result.values[i][idx] = column[i]
+		copy(result.values[i][idx+1:], m.values[i][idx:])
+	}
+
+	return result
+}
+
+// DeleteRows removes the rows at the given indices and returns a pointer to the resulting matrix.
+// Repeated indices are removed only once.
+// Returns nil if any index is out of bounds.
+func DeleteRows(m Matrix, indices ...int) *Matrix {
+	// Check if row indices are valid and mark the rows to remove
+	remove := make([]bool, m.rows)
+	count := 0
+	for _, idx := range indices {
+		if idx < 0 || idx >= m.rows {
+			return nil
+		}
+		if !remove[idx] {
+			remove[idx] = true
+			count++
+		}
+	}
+
+	// Create a new matrix with the remaining number of rows
+	result := &Matrix{
+		rows:    m.rows - count,
+		columns: m.columns,
+		values:  make([][]float64, 0, m.rows-count),
+	}
+
+	// Copy the rows that are kept
+	for i := 0; i < m.rows; i++ {
+		if remove[i] {
+			continue
+		}
+		row := make([]float64, m.columns)
+		copy(row, m.values[i])
+		result.values = append(result.values, row)
+	}
+
+	return result
+}
+
+// DeleteColumns removes the columns at the given indices and returns a pointer to the resulting matrix.
+// Repeated indices are removed only once.
+// Returns nil if any index is out of bounds.
+func DeleteColumns(m Matrix, indices ...int) *Matrix {
+	// Check if column indices are valid and mark the columns to remove
+	remove := make([]bool, m.columns)
+	count := 0
+	for _, idx := range indices {
+		if idx < 0 || idx >= m.columns {
+			return nil
+		}
+		if !remove[idx] {
+			remove[idx] = true
+			count++
+		}
+	}
+
+	// Create a new matrix with the remaining number of columns
+	result := &Matrix{
+		rows:    m.rows,
+		columns: m.columns - count,
+		values:  make([][]float64, m.rows),
+	}
+
+	// Copy the columns that are kept
+	for i := 0; i < m.rows; i++ {
+		result.values[i] = make([]float64, 0, m.columns-count)
+		for j := 0; j < m.columns; j++ {
+			if !remove[j] {
+				result.values[i] = append(result.values[i], m.values[i][j])
+			}
+		}
+	}
+
+	return result
+}
+
+// isPermutation reports whether perm contains every index in [0, n) exactly once.
+func isPermutation(perm []int, n int) bool {
+	if len(perm) != n {
+		return false
+	}
+
+	seen := make([]bool, n)
+	for _, p := range perm {
+		if p < 0 || p >= n || seen[p] {
+			return false
+		}
+		seen[p] = true
+	}
+
+	return true
+}
+
+// PermuteRows reorders the rows of the matrix so that row i of the result is row perm[i] of the original,
+// and returns a pointer to the resulting matrix.
+// Returns nil if perm is not a permutation of the row indices.
+func PermuteRows(m Matrix, perm []int) *Matrix {
+	// Check if perm is a valid permutation
+	if !isPermutation(perm, m.rows) {
+		return nil
+	}
+
+	// Create a new matrix with the same dimensions
+	result := &Matrix{
+		rows:    m.rows,
+		columns: m.columns,
+		values:  make([][]float64, m.rows),
+	}
+
+	// Copy each row from its source position
+	for i := 0; i < m.rows; i++ {
+		result.values[i] = make([]float64, m.columns)
+		copy(result.values[i], m.values[perm[i]])
+	}
+
+	return result
+}
+
+// PermuteColumns reorders the columns of the matrix so that column j of the result is column perm[j] of the original,
+// and returns a pointer to the resulting matrix.
+// Returns nil if perm is not a permutation of the column indices.
+func PermuteColumns(m Matrix, perm []int) *Matrix {
+	// Check if perm is a valid permutation
+	if !isPermutation(perm, m.columns) {
+		return nil
+	}
+
+	// Create a new matrix with the same dimensions
+	result := &Matrix{
+		rows:    m.rows,
+		columns: m.columns,
+		values:  make([][]float64, m.rows),
+	}
+
+	// Copy each column from its source position
+	for i := 0; i < m.rows; i++ {
+		result.values[i] = make([]float64, m.columns)
+		for j := 0; j < m.columns; j++ {
+			result.values[i][j] = m.values[i][perm[j]]
+		}
+	}
+
+	return result
+}
+
 // SwapRows swaps two rows in the matrix and returns a pointer to the resulting matrix.
 // Returns nil if either row index is out of bounds.
 func SwapRows(m Matrix, row1, row2 int) *Matrix {
@@ -389,6 +633,18 @@ func TransposeMatrix(m Matrix) *Matrix {
 
 // RowEchelonForm performs Gaussian elimination on a matrix and returns a pointer to the resulting matrix in row echelon form.
 func RowEchelonForm(m Matrix) *Matrix {
+	return eliminate(m, false, nil)
+}
+
+// ReducedRowEchelonForm performs Gauss-Jordan elimination on a matrix and returns a pointer to the resulting matrix in reduced row echelon form.
+func ReducedRowEchelonForm(m Matrix) *Matrix {
+	return eliminate(m, true, nil)
+}
+
+// eliminate performs Gaussian elimination on a matrix, or Gauss-Jordan elimination if reduced is true,
+// and returns a pointer to the resulting matrix. If record is not nil, it is called after every
+// elementary row operation with the operation and the matrix it produced.
+func eliminate(m Matrix, reduced bool, record func(RowOperation, Matrix)) *Matrix {
 	// Create a copy of the input matrix to work with
 	result := &Matrix{
 		rows:    m.rows,
@@ -404,6 +660,18 @@ func RowEchelonForm(m Matrix) *Matrix {
 		}
 	}
 
+	// apply replaces the working matrix with the result of an elementary row operation and records it
+	apply := func(op RowOperation, temp *Matrix) bool {
+		if temp == nil {
+			return false // This should not happen, but handle it just in case
+		}
+		result = temp
+		if record != nil {
+			record(op, *result)
+		}
+		return true
+	}
+
 	// Current row being processed
 	row := 0
 
@@ -425,37 +693,36 @@ func RowEchelonForm(m Matrix) *Matrix {
 
 		// Swap the pivot row with the current row if they're different
 		if pivotRow != row {
-			// Create a temporary matrix for the swap
-			temp := SwapRows(*result, row, pivotRow)
-			if temp == nil {
-				return nil // This should not happen, but handle it just in case
+			op := RowOperation{Kind: RowSwap, Target: row, Source: pivotRow}
+			if !apply(op, SwapRows(*result, row, pivotRow)) {
+				return nil
 			}
-			result = temp
 		}
 
 		// Scale the pivot row to make the pivot element 1
 		pivotValue := result.values[row][col]
 		if pivotValue != 1 {
-			// Create a temporary matrix for the scaling
-			temp := MultiplyRow(*result, row, 1/pivotValue)
-			if temp == nil {
-				return nil // This should not happen, but handle it just in case
+			op := RowOperation{Kind: RowScale, Target: row, Source: row, Scalar: 1 / pivotValue}
+			if !apply(op, MultiplyRow(*result, row, 1/pivotValue)) {
+				return nil
 			}
-			result = temp
 		}
 
-		// Eliminate all other elements in the current column below the pivot
-		for i := row + 1; i < m.rows; i++ {
-			if result.values[i][col] != 0 {
+		// Eliminate the other elements in the current column: only those below the pivot for row echelon form,
+		// both above and below the pivot for reduced row echelon form
+		start := row + 1
+		if reduced {
+			start = 0
+		}
+		for i := start; i < m.rows; i++ {
+			if i != row && result.values[i][col] != 0 {
 				// Calculate the scalar to multiply the pivot row by
 				scalar := -result.values[i][col]
 
-				// Create a temporary matrix for the elimination
-				temp := AddScaledRow(*result, i, row, scalar)
-				if temp == nil {
-					return nil // This should not happen, but handle it just in case
+				op := RowOperation{Kind: RowAddScaled, Target: i, Source: row, Scalar: scalar}
+				if !apply(op, AddScaledRow(*result, i, row, scalar)) {
+					return nil
 				}
-				result = temp
 			}
 		}
 
@@ -466,9 +733,35 @@ func RowEchelonForm(m Matrix) *Matrix {
 	return result
 }
 
-// ReducedRowEchelonForm performs Gauss-Jordan elimination on a matrix and returns a pointer to the resulting matrix in reduced row echelon form.
-func ReducedRowEchelonForm(m Matrix) *Matrix {
-	// Create a copy of the input matrix to work with
+// Slice returns a pointer to a view of the rows [r0, r1) and columns [c0, c1) of the matrix.
+// The view shares storage with the original matrix, so changes to either are visible in both.
+// Empty ranges are allowed and give a view with no rows or no columns.
+// Returns nil if the bounds are out of range or reversed.
+func Slice(m Matrix, r0, r1, c0, c1 int) *Matrix {
+	// Check if the bounds are valid
+	if r0 < 0 || r1 > m.rows || r0 > r1 || c0 < 0 || c1 > m.columns || c0 > c1 {
+		return nil
+	}
+
+	// Create a new matrix header for the selected block
+	result := &Matrix{
+		rows:    r1 - r0,
+		columns: c1 - c0,
+		values:  make([][]float64, r1-r0),
+	}
+
+	// Reference the selected part of each row without copying it.
+	// The capacity is limited so that the view can never grow into the parent's storage.
+	for i := 0; i < result.rows; i++ {
+		result.values[i] = m.values[r0+i][c0:c1:c1]
+	}
+
+	return result
+}
+
+// Clone returns a pointer to a deep copy of the matrix that shares no storage with the original.
+func Clone(m Matrix) *Matrix {
+	// Create a new matrix with the same dimensions
 	result := &Matrix{
 		rows:    m.rows,
 		columns: m.columns,
@@ -478,68 +771,225 @@ func ReducedRowEchelonForm(m Matrix) *Matrix {
 	// Copy values from the original matrix
 	for i := 0; i < m.rows; i++ {
 		result.values[i] = make([]float64, m.columns)
-		for j := 0; j < m.columns; j++ {
-			result.values[i][j] = m.values[i][j]
+		copy(result.values[i], m.values[i])
+	}
+
+	return result
+}
+
+// Order selects how the elements of a matrix are laid out in a flat sequence.
+type Order int
+
+const (
+	// RowMajor lays out elements row by row.
+	RowMajor Order = iota
+	// ColumnMajor lays out elements column by column.
+	ColumnMajor
+)
+
+// HStack places the matrices side by side and returns a pointer to the resulting matrix.
+// Returns nil if no matrices are given or they don't all have the same number of rows.
+func HStack(ms ...Matrix) *Matrix {
+	// Check if the matrices can be stacked horizontally
+	if len(ms) == 0 {
+		return nil
+	}
+	columns := 0
+	for _, m := range ms {
+		if m.rows != ms[0].rows {
+			return nil
 		}
+		columns += m.columns
 	}
 
-	// Current row being processed
-	row := 0
+	// Create a new matrix with dimensions ms[0].rows × (sum of columns)
+	result := &Matrix{
+		rows:    ms[0].rows,
+		columns: columns,
+		values:  make([][]float64, ms[0].rows),
+	}
 
-	// Process each column
-	for col := 0; col < m.columns && row < m.rows; col++ {
-		// Find the pivot row (first row with non-zero element in current column)
-		pivotRow := -1
-		for i := row; i < m.rows; i++ {
-			if result.values[i][col] != 0 {
-				pivotRow = i
-				break
-			}
+	// Copy each row of every matrix into place
+	for i := 0; i < result.rows; i++ {
+		result.values[i] = make([]float64, 0, columns)
+		for _, m := range ms {
+			result.values[i] = append(result.values[i], m.values[i]...)
 		}
+	}
 
-		// If no pivot found in this column, move to the next column
-		if pivotRow == -1 {
-			continue
+	return result
+}
+
+// VStack places the matrices on top of each other and returns a pointer to the resulting matrix.
+// Returns nil if no matrices are given or they don't all have the same number of columns.
+func VStack(ms ...Matrix) *Matrix {
+	// Check if the matrices can be stacked vertically
+	if len(ms) == 0 {
+		return nil
+	}
+	rows := 0
+	for _, m := range ms {
+		if m.columns != ms[0].columns {
+			return nil
 		}
+		rows += m.rows
+	}
 
-		// Swap the pivot row with the current row if they're different
-		if pivotRow != row {
-			// Create a temporary matrix for the swap
-			temp := SwapRows(*result, row, pivotRow)
-			if temp == nil {
-				return nil // This should not happen, but handle it just in case
-			}
-			result = temp
+	// Create a new matrix with dimensions (sum of rows) × ms[0].columns
+	result := &Matrix{
+		rows:    rows,
+		columns: ms[0].columns,
+		values:  make([][]float64, 0, rows),
+	}
+
+	// Copy the rows of every matrix in order
+	for _, m := range ms {
+		for i := 0; i < m.rows; i++ {
+			row := make([]float64, m.columns)
+			copy(row, m.values[i])
+			result.values = append(result.values, row)
 		}
+	}
 
-		// Scale the pivot row to make the pivot element 1
-		pivotValue := result.values[row][col]
-		if pivotValue != 1 {
-			// Create a temporary matrix for the scaling
-			temp := MultiplyRow(*result, row, 1/pivotValue)
-			if temp == nil {
-				return nil // This should not happen, but handle it just in case
+	return result
+}
+
+// Block assembles a block matrix from a grid of matrices and returns a pointer to the resulting matrix.
+// Returns nil if the grid is empty or ragged, if the matrices in a block row have different numbers of rows,
+// or if the matrices in a block column have different numbers of columns.
+func Block(blocks [][]Matrix) *Matrix {
+	// Check if the grid is rectangular and non-empty
+	if len(blocks) == 0 || len(blocks[0]) == 0 {
+		return nil
+	}
+	for _, blockRow := range blocks {
+		if len(blockRow) != len(blocks[0]) {
+			return nil
+		}
+	}
+
+	// Check if the block columns have consistent widths
+	for _, blockRow := range blocks {
+		for j, b := range blockRow {
+			if b.columns != blocks[0][j].columns {
+				return nil
 			}
-			result = temp
 		}
+	}
 
-		// Eliminate all other elements in the current column (both above and below the pivot)
-		for i := 0; i < m.rows; i++ {
-			if i != row && result.values[i][col] != 0 {
-				// Calculate the scalar to multiply the pivot row by
-				scalar := -result.values[i][col]
+	// Stack each block row horizontally, then stack the block rows vertically
+	rows := make([]Matrix, len(blocks))
+	for i, blockRow := range blocks {
+		row := HStack(blockRow...)
+		if row == nil {
+			return nil
+		}
+		rows[i] = *row
+	}
 
-				// Create a temporary matrix for the elimination
-				temp := AddScaledRow(*result, i, row, scalar)
-				if temp == nil {
-					return nil // This should not happen, but handle it just in case
-				}
-				result = temp
+	return VStack(rows...)
+}
+
+// Flatten returns the elements of the matrix as a single slice in the given order.
+func Flatten(m Matrix, order Order) []float64 {
+	result := make([]float64, 0, m.rows*m.columns)
+
+	if order == ColumnMajor {
+		for j := 0; j < m.columns; j++ {
+			for i := 0; i < m.rows; i++ {
+				result = append(result, m.values[i][j])
 			}
 		}
+		return result
+	}
 
-		// Move to the next row
-		row++
+	for i := 0; i < m.rows; i++ {
+		result = append(result, m.values[i]...)
+	}
+	return result
+}
+
+// Reshape rearranges the elements of the matrix into a rows × cols matrix, reading and writing elements
+// in the given order, and returns a pointer to the resulting matrix.
+// Returns nil if the new shape doesn't hold exactly the same number of elements.
+func Reshape(m Matrix, rows, cols int, order Order) *Matrix {
+	// Check if the new shape holds the same number of elements
+	if rows < 0 || cols < 0 || rows*cols != m.rows*m.columns {
+		return nil
+	}
+
+	// Create a new matrix with the requested dimensions
+	result := &Matrix{
+		rows:    rows,
+		columns: cols,
+		values:  make([][]float64, rows),
+	}
+	for i := 0; i < rows; i++ {
+		result.values[i] = make([]float64, cols)
+	}
+
+	// Fill the new matrix from the flattened elements in the same order
+	flat := Flatten(m, order)
+	for k, v := range flat {
+		if order == ColumnMajor {
+			result.values[k%rows][k/rows] = v
+		} else {
+			result.values[k/cols][k%cols] = v
+		}
+	}
+
+	return result
+}
+
+// Tile repeats the whole matrix rowReps times vertically and colReps times horizontally
+// and returns a pointer to the resulting matrix.
+// Returns nil if either repetition count is less than 1.
+func Tile(m Matrix, rowReps, colReps int) *Matrix {
+	// Check if the repetition counts are valid
+	if rowReps < 1 || colReps < 1 {
+		return nil
+	}
+
+	// Create a new matrix with dimensions (m.rows * rowReps) × (m.columns * colReps)
+	result := &Matrix{
+		rows:    m.rows * rowReps,
+		columns: m.columns * colReps,
+		values:  make([][]float64, m.rows*rowReps),
+	}
+
+	// Each result element comes from the original element at the same position modulo the original shape
+	for i := 0; i < result.rows; i++ {
+		result.values[i] = make([]float64, result.columns)
+		for j := 0; j < result.columns; j++ {
+			result.values[i][j] = m.values[i%m.rows][j%m.columns]
+		}
+	}
+
+	return result
+}
+
+// Repeat repeats every element of the matrix rowReps times vertically and colReps times horizontally
+// and returns a pointer to the resulting matrix.
+// Returns nil if either repetition count is less than 1.
+func Repeat(m Matrix, rowReps, colReps int) *Matrix {
+	// Check if the repetition counts are valid
+	if rowReps < 1 || colReps < 1 {
+		return nil
+	}
+
+	// Create a new matrix with dimensions (m.rows * rowReps) × (m.columns * colReps)
+	result := &Matrix{
+		rows:    m.rows * rowReps,
+		columns: m.columns * colReps,
+		values:  make([][]float64, m.rows*rowReps),
+	}
+
+	// Each result element comes from the original element it expands
+	for i := 0; i < result.rows; i++ {
+		result.values[i] = make([]float64, result.columns)
+		for j := 0; j < result.columns; j++ {
+			result.values[i][j] = m.values[i/rowReps][j/colReps]
+		}
 	}
 
 	return result
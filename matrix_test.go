@@ -15,22 +15,11 @@ func matricesEqual(t *testing.T, expected, actual *Matrix) bool {
 		return false
 	}
 
-	if expected.rows != actual.rows || expected.columns != actual.columns {
-		t.Errorf("Matrix dimensions don't match: expected %dx%d, got %dx%d",
-			expected.rows, expected.columns, actual.rows, actual.columns)
+	if d := Diff(*expected, *actual, 0, 0); d != nil {
+		t.Errorf("Matrices don't match: %v", d)
 		return false
 	}
 
-	for i := 0; i < expected.rows; i++ {
-		for j := 0; j < expected.columns; j++ {
-			if expected.values[i][j] != actual.values[i][j] {
-				t.Errorf("Matrix values don't match at position [%d][%d]: expected %f, got %f",
-					i, j, expected.values[i][j], actual.values[i][j])
-				return false
-			}
-		}
-	}
-
 	return true
 }
 
@@ -328,6 +317,224 @@ func TestAppendColumn(t *testing.T) {
 	}
 }
 
+// TestInsertRow tests the InsertRow function
+func TestInsertRow(t *testing.T) {
+	m := NewMatrix(2, 2, [][]float64{
+		{1, 2},
+		{3, 4},
+	})
+
+	// Test case 1: Inserting a row in the middle
+	expected1 := &Matrix{
+		rows:    3,
+		columns: 2,
+		values: [][]float64{
+			{1, 2},
+			{5, 6},
+			{3, 4},
+		},
+	}
+
+	result1 := InsertRow(m, 1, []float64{5, 6})
+	if !matricesEqual(t, expected1, result1) {
+		t.Errorf("InsertRow failed for an index in the middle")
+	}
+
+	// Test case 2: Inserting a row at the start and at the end
+	expected2 := &Matrix{
+		rows:    3,
+		columns: 2,
+		values: [][]float64{
+			{5, 6},
+			{1, 2},
+			{3, 4},
+		},
+	}
+
+	result2 := InsertRow(m, 0, []float64{5, 6})
+	if !matricesEqual(t, expected2, result2) {
+		t.Errorf("InsertRow failed for index 0")
+	}
+
+	result3 := InsertRow(m, 2, []float64{5, 6})
+	if !matricesEqual(t, AppendRow(m, []float64{5, 6}), result3) {
+		t.Errorf("InsertRow at the end should match AppendRow")
+	}
+
+	// Test case 3: Invalid index or row length
+	if InsertRow(m, 3, []float64{5, 6}) != nil {
+		t.Errorf("InsertRow should return nil for an out of bounds index")
+	}
+	if InsertRow(m, 1, []float64{5}) != nil {
+		t.Errorf("InsertRow should return nil for a row of the wrong length")
+	}
+}
+
+// TestInsertColumn tests the InsertColumn function
+func TestInsertColumn(t *testing.T) {
+	m := NewMatrix(2, 2, [][]float64{
+		{1, 2},
+		{3, 4},
+	})
+
+	// Test case 1: Inserting a column in the middle
+	expected1 := &Matrix{
+		rows:    2,
+		columns: 3,
+		values: [][]float64{
+			{1, 5, 2},
+			{3, 6, 4},
+		},
+	}
+
+	result1 := InsertColumn(m, 1, []float64{5, 6})
+	if !matricesEqual(t, expected1, result1) {
+		t.Errorf("InsertColumn failed for an index in the middle")
+	}
+
+	// Test case 2: Inserting a column at the end
+	result2 := InsertColumn(m, 2, []float64{5, 6})
+	if !matricesEqual(t, AppendColumn(m, []float64{5, 6}), result2) {
+		t.Errorf("InsertColumn at the end should match AppendColumn")
+	}
+
+	// Test case 3: Invalid index or column length
+	if InsertColumn(m, -1, []float64{5, 6}) != nil {
+		t.Errorf("InsertColumn should return nil for a negative index")
+	}
+	if InsertColumn(m, 0, []float64{5, 6, 7}) != nil {
+		t.Errorf("InsertColumn should return nil for a column of the wrong length")
+	}
+}
+
+// TestDeleteRows tests the DeleteRows function
+func TestDeleteRows(t *testing.T) {
+	m := NewMatrix(4, 2, [][]float64{
+		{1, 2},
+		{3, 4},
+		{5, 6},
+		{7, 8},
+	})
+
+	// Test case 1: Deleting several rows, including a repeated index
+	expected1 := &Matrix{
+		rows:    2,
+		columns: 2,
+		values: [][]float64{
+			{3, 4},
+			{7, 8},
+		},
+	}
+
+	result1 := DeleteRows(m, 2, 0, 2)
+	if !matricesEqual(t, expected1, result1) {
+		t.Errorf("DeleteRows failed for rows 0 and 2")
+	}
+
+	// Test case 2: Deleting no rows copies the matrix
+	result2 := DeleteRows(m)
+	if !matricesEqual(t, &m, result2) {
+		t.Errorf("DeleteRows with no indices should return a copy")
+	}
+
+	// Test case 3: Invalid index
+	if DeleteRows(m, 4) != nil {
+		t.Errorf("DeleteRows should return nil for an out of bounds index")
+	}
+}
+
+// TestDeleteColumns tests the DeleteColumns function
+func TestDeleteColumns(t *testing.T) {
+	m := NewMatrix(2, 4, [][]float64{
+		{1, 2, 3, 4},
+		{5, 6, 7, 8},
+	})
+
+	// Test case 1: Deleting several columns
+	expected1 := &Matrix{
+		rows:    2,
+		columns: 2,
+		values: [][]float64{
+			{1, 4},
+			{5, 8},
+		},
+	}
+
+	result1 := DeleteColumns(m, 1, 2)
+	if !matricesEqual(t, expected1, result1) {
+		t.Errorf("DeleteColumns failed for columns 1 and 2")
+	}
+
+	// Test case 2: Invalid index
+	if DeleteColumns(m, 0, -1) != nil {
+		t.Errorf("DeleteColumns should return nil for a negative index")
+	}
+}
+
+// TestPermuteRows tests the PermuteRows function
+func TestPermuteRows(t *testing.T) {
+	m := NewMatrix(3, 2, [][]float64{
+		{1, 2},
+		{3, 4},
+		{5, 6},
+	})
+
+	// Test case 1: Applying a cyclic permutation
+	expected1 := &Matrix{
+		rows:    3,
+		columns: 2,
+		values: [][]float64{
+			{5, 6},
+			{1, 2},
+			{3, 4},
+		},
+	}
+
+	result1 := PermuteRows(m, []int{2, 0, 1})
+	if !matricesEqual(t, expected1, result1) {
+		t.Errorf("PermuteRows failed for permutation [2 0 1]")
+	}
+
+	// Test case 2: Invalid permutations
+	if PermuteRows(m, []int{0, 1}) != nil {
+		t.Errorf("PermuteRows should return nil for a permutation of the wrong length")
+	}
+	if PermuteRows(m, []int{0, 1, 1}) != nil {
+		t.Errorf("PermuteRows should return nil for a repeated index")
+	}
+	if PermuteRows(m, []int{0, 1, 3}) != nil {
+		t.Errorf("PermuteRows should return nil for an out of bounds index")
+	}
+}
+
+// TestPermuteColumns tests the PermuteColumns function
+func TestPermuteColumns(t *testing.T) {
+	m := NewMatrix(2, 3, [][]float64{
+		{1, 2, 3},
+		{4, 5, 6},
+	})
+
+	// Test case 1: Reversing the columns
+	expected1 := &Matrix{
+		rows:    2,
+		columns: 3,
+		values: [][]float64{
+			{3, 2, 1},
+			{6, 5, 4},
+		},
+	}
+
+	result1 := PermuteColumns(m, []int{2, 1, 0})
+	if !matricesEqual(t, expected1, result1) {
+		t.Errorf("PermuteColumns failed for permutation [2 1 0]")
+	}
+
+	// Test case 2: Invalid permutation
+	if PermuteColumns(m, []int{2, 2, 0}) != nil {
+		t.Errorf("PermuteColumns should return nil for a repeated index")
+	}
+}
+
 // TestSwapRows tests the SwapRows function
 func TestSwapRows(t *testing.T) {
 	// Test case 1: Swapping rows in a 3x3 matrix
@@ -928,3 +1135,355 @@ func TestReducedRowEchelonForm(t *testing.T) {
 		}
 	}
 }
+
+// TestSlice tests the Slice function
+func TestSlice(t *testing.T) {
+	// Test case 1: Taking a block from the middle of a matrix
+	m1 := NewMatrix(3, 4, [][]float64{
+		{1, 2, 3, 4},
+		{5, 6, 7, 8},
+		{9, 10, 11, 12},
+	})
+
+	expected1 := &Matrix{
+		rows:    2,
+		columns: 2,
+		values: [][]float64{
+			{6, 7},
+			{10, 11},
+		},
+	}
+
+	result1 := Slice(m1, 1, 3, 1, 3)
+	if !matricesEqual(t, expected1, result1) {
+		t.Errorf("Slice failed for 2x2 block of a 3x4 matrix")
+	}
+
+	// Test case 2: The view shares storage with the parent
+	result1.values[0][0] = 60
+	if m1.values[1][1] != 60 {
+		t.Errorf("Slice should share storage with the parent matrix")
+	}
+	m1.values[2][2] = 110
+	if result1.values[1][1] != 110 {
+		t.Errorf("Slice should observe changes made to the parent matrix")
+	}
+
+	// Test case 3: Views work with existing operations
+	a3 := NewMatrix(2, 2, [][]float64{
+		{1, 1},
+		{1, 1},
+	})
+
+	expected3 := &Matrix{
+		rows:    2,
+		columns: 2,
+		values: [][]float64{
+			{61, 8},
+			{11, 111},
+		},
+	}
+
+	result3 := AddMatrices(*result1, a3)
+	if !matricesEqual(t, expected3, result3) {
+		t.Errorf("AddMatrices failed for a sliced view")
+	}
+
+	// Test case 4: Invalid bounds
+	if Slice(m1, 0, 4, 0, 1) != nil {
+		t.Errorf("Slice should return nil for out of range rows")
+	}
+	if Slice(m1, 0, 1, 2, 1) != nil {
+		t.Errorf("Slice should return nil for a reversed column range")
+	}
+	if Slice(m1, -1, 1, 0, 1) != nil {
+		t.Errorf("Slice should return nil for negative bounds")
+	}
+
+	// Test case 5: Empty ranges give empty views
+	if result5 := Slice(m1, 0, 2, 2, 2); result5 == nil || result5.rows != 2 || result5.columns != 0 {
+		t.Errorf("Slice should return a 2x0 view for an empty column range")
+	}
+	if result5 := Slice(m1, 3, 3, 0, 3); result5 == nil || result5.rows != 0 || result5.columns != 3 {
+		t.Errorf("Slice should return a 0x3 view for an empty row range")
+	}
+	if result5 := Slice(zeroMatrix(0, 0), 0, 0, 0, 0); result5 == nil || result5.rows != 0 || result5.columns != 0 {
+		t.Errorf("Slice should return a 0x0 view of a 0x0 matrix")
+	}
+}
+
+// TestClone tests the Clone function
+func TestClone(t *testing.T) {
+	// Test case 1: Cloning a view produces an independent copy
+	m1 := NewMatrix(2, 3, [][]float64{
+		{1, 2, 3},
+		{4, 5, 6},
+	})
+
+	view := Slice(m1, 0, 2, 1, 3)
+	expected1 := &Matrix{
+		rows:    2,
+		columns: 2,
+		values: [][]float64{
+			{2, 3},
+			{5, 6},
+		},
+	}
+
+	result1 := Clone(*view)
+	if !matricesEqual(t, expected1, result1) {
+		t.Errorf("Clone failed for a sliced view")
+	}
+
+	// Test case 2: Changes to the clone do not affect the original
+	result1.values[0][0] = 20
+	if m1.values[0][1] != 2 || view.values[0][0] != 2 {
+		t.Errorf("Clone should not share storage with the original matrix")
+	}
+}
+
+// TestHStack tests the HStack function
+func TestHStack(t *testing.T) {
+	// Test case 1: Building an augmented matrix
+	a := NewMatrix(2, 2, [][]float64{
+		{1, 2},
+		{3, 4},
+	})
+	b := NewMatrix(2, 1, [][]float64{
+		{5},
+		{6},
+	})
+
+	expected1 := &Matrix{
+		rows:    2,
+		columns: 5,
+		values: [][]float64{
+			{1, 2, 5, 1, 2},
+			{3, 4, 6, 3, 4},
+		},
+	}
+
+	result1 := HStack(a, b, a)
+	if !matricesEqual(t, expected1, result1) {
+		t.Errorf("HStack failed for three matrices")
+	}
+
+	// Test case 2: Mismatched rows or no matrices
+	c := NewMatrix(1, 2, [][]float64{{1, 2}})
+	if HStack(a, c) != nil {
+		t.Errorf("HStack should return nil for matrices with different numbers of rows")
+	}
+	if HStack() != nil {
+		t.Errorf("HStack should return nil when no matrices are given")
+	}
+}
+
+// TestVStack tests the VStack function
+func TestVStack(t *testing.T) {
+	// Test case 1: Stacking two matrices
+	a := NewMatrix(1, 2, [][]float64{{1, 2}})
+	b := NewMatrix(2, 2, [][]float64{
+		{3, 4},
+		{5, 6},
+	})
+
+	expected1 := &Matrix{
+		rows:    3,
+		columns: 2,
+		values: [][]float64{
+			{1, 2},
+			{3, 4},
+			{5, 6},
+		},
+	}
+
+	result1 := VStack(a, b)
+	if !matricesEqual(t, expected1, result1) {
+		t.Errorf("VStack failed for two matrices")
+	}
+
+	// Test case 2: Mismatched columns
+	c := NewMatrix(1, 3, [][]float64{{1, 2, 3}})
+	if VStack(a, c) != nil {
+		t.Errorf("VStack should return nil for matrices with different numbers of columns")
+	}
+}
+
+// TestBlock tests the Block function
+func TestBlock(t *testing.T) {
+	// Test case 1: Assembling a 2x2 block matrix
+	a := NewMatrix(1, 1, [][]float64{{1}})
+	b := NewMatrix(1, 2, [][]float64{{2, 3}})
+	c := NewMatrix(2, 1, [][]float64{
+		{4},
+		{7},
+	})
+	d := NewMatrix(2, 2, [][]float64{
+		{5, 6},
+		{8, 9},
+	})
+
+	expected1 := &Matrix{
+		rows:    3,
+		columns: 3,
+		values: [][]float64{
+			{1, 2, 3},
+			{4, 5, 6},
+			{7, 8, 9},
+		},
+	}
+
+	result1 := Block([][]Matrix{{a, b}, {c, d}})
+	if !matricesEqual(t, expected1, result1) {
+		t.Errorf("Block failed for a 2x2 grid of blocks")
+	}
+
+	// Test case 2: Inconsistent block shapes
+	if Block([][]Matrix{{a, b}, {d, c}}) != nil {
+		t.Errorf("Block should return nil for blocks with inconsistent shapes")
+	}
+	if Block([][]Matrix{{a, b}, {c}}) != nil {
+		t.Errorf("Block should return nil for a ragged grid")
+	}
+}
+
+// TestFlatten tests the Flatten function
+func TestFlatten(t *testing.T) {
+	m := NewMatrix(2, 3, [][]float64{
+		{1, 2, 3},
+		{4, 5, 6},
+	})
+
+	// Test case 1: Row-major order
+	expected1 := []float64{1, 2, 3, 4, 5, 6}
+	result1 := Flatten(m, RowMajor)
+	for i := range expected1 {
+		if result1[i] != expected1[i] {
+			t.Errorf("Flatten failed for row-major order: expected %v, got %v", expected1, result1)
+			break
+		}
+	}
+
+	// Test case 2: Column-major order
+	expected2 := []float64{1, 4, 2, 5, 3, 6}
+	result2 := Flatten(m, ColumnMajor)
+	for i := range expected2 {
+		if result2[i] != expected2[i] {
+			t.Errorf("Flatten failed for column-major order: expected %v, got %v", expected2, result2)
+			break
+		}
+	}
+}
+
+// TestReshape tests the Reshape function
+func TestReshape(t *testing.T) {
+	m := NewMatrix(2, 3, [][]float64{
+		{1, 2, 3},
+		{4, 5, 6},
+	})
+
+	// Test case 1: Reshaping in row-major order
+	expected1 := &Matrix{
+		rows:    3,
+		columns: 2,
+		values: [][]float64{
+			{1, 2},
+			{3, 4},
+			{5, 6},
+		},
+	}
+
+	result1 := Reshape(m, 3, 2, RowMajor)
+	if !matricesEqual(t, expected1, result1) {
+		t.Errorf("Reshape failed for row-major order")
+	}
+
+	// Test case 2: Reshaping in column-major order
+	expected2 := &Matrix{
+		rows:    3,
+		columns: 2,
+		values: [][]float64{
+			{1, 5},
+			{4, 3},
+			{2, 6},
+		},
+	}
+
+	result2 := Reshape(m, 3, 2, ColumnMajor)
+	if !matricesEqual(t, expected2, result2) {
+		t.Errorf("Reshape failed for column-major order")
+	}
+
+	// Test case 3: Incompatible shape
+	if Reshape(m, 4, 2, RowMajor) != nil {
+		t.Errorf("Reshape should return nil for a shape with a different number of elements")
+	}
+}
+
+// TestTile tests the Tile function
+func TestTile(t *testing.T) {
+	m := NewMatrix(1, 2, [][]float64{{1, 2}})
+
+	// Test case 1: Tiling in both directions
+	expected1 := &Matrix{
+		rows:    2,
+		columns: 4,
+		values: [][]float64{
+			{1, 2, 1, 2},
+			{1, 2, 1, 2},
+		},
+	}
+
+	result1 := Tile(m, 2, 2)
+	if !matricesEqual(t, expected1, result1) {
+		t.Errorf("Tile failed for 2x2 repetitions")
+	}
+
+	// Test case 2: Invalid repetition count
+	if Tile(m, 0, 1) != nil {
+		t.Errorf("Tile should return nil for a repetition count of 0")
+	}
+}
+
+// TestRepeat tests the Repeat function
+func TestRepeat(t *testing.T) {
+	m := NewMatrix(1, 2, [][]float64{{1, 2}})
+
+	// Test case 1: Repeating each element in both directions
+	expected1 := &Matrix{
+		rows:    2,
+		columns: 4,
+		values: [][]float64{
+			{1, 1, 2, 2},
+			{1, 1, 2, 2},
+		},
+	}
+
+	result1 := Repeat(m, 2, 2)
+	if !matricesEqual(t, expected1, result1) {
+		t.Errorf("Repeat failed for 2x2 repetitions")
+	}
+
+	// Test case 2: Invalid repetition count
+	if Repeat(m, 1, -1) != nil {
+		t.Errorf("Repeat should return nil for a negative repetition count")
+	}
+}
+
+// TestIdentityMatrix tests the IdentityMatrix function
+func TestIdentityMatrix(t *testing.T) {
+	expected := &Matrix{
+		rows:    3,
+		columns: 3,
+		values: [][]float64{
+			{1, 0, 0},
+			{0, 1, 0},
+			{0, 0, 1},
+		},
+	}
+
+	result := IdentityMatrix(3)
+	if !matricesEqual(t, expected, &result) {
+		t.Errorf("IdentityMatrix failed for n = 3")
+	}
+}
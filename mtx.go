@@ -0,0 +1,347 @@
+package matrix
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// MatrixMarketFormat is the storage format of a Matrix Market file.
+type MatrixMarketFormat string
+
+// MatrixMarketField is the type of the values in a Matrix Market file.
+type MatrixMarketField string
+
+// MatrixMarketSymmetry is the symmetry structure declared by a Matrix Market file.
+type MatrixMarketSymmetry string
+
+const (
+	// MatrixMarketCoordinate stores only the listed entries, as "row column value" triplets.
+	MatrixMarketCoordinate MatrixMarketFormat = "coordinate"
+	// MatrixMarketArray stores every entry in column-major order.
+	MatrixMarketArray MatrixMarketFormat = "array"
+
+	// MatrixMarketReal stores floating-point values.
+	MatrixMarketReal MatrixMarketField = "real"
+	// MatrixMarketInteger stores integer values.
+	MatrixMarketInteger MatrixMarketField = "integer"
+	// MatrixMarketPattern stores only the positions of the entries, which are read as 1.
+	MatrixMarketPattern MatrixMarketField = "pattern"
+
+	// MatrixMarketGeneral stores every entry.
+	MatrixMarketGeneral MatrixMarketSymmetry = "general"
+	// MatrixMarketSymmetric stores the lower triangle of a matrix with a[i][j] == a[j][i].
+	MatrixMarketSymmetric MatrixMarketSymmetry = "symmetric"
+	// MatrixMarketSkewSymmetric stores the strict lower triangle of a matrix with a[i][j] == -a[j][i].
+	MatrixMarketSkewSymmetric MatrixMarketSymmetry = "skew-symmetric"
+)
+
+// MatrixMarketOptions configures WriteMatrixMarket.
+// The zero value writes the coordinate format with real values and general symmetry.
+type MatrixMarketOptions struct {
+	Format   MatrixMarketFormat
+	Field    MatrixMarketField
+	Symmetry MatrixMarketSymmetry
+}
+
+// mtxMaxElements limits the declared size ReadMatrixMarket accepts, since the matrix is allocated in full
+// before any entry is read. Every row costs a slice header as well as its elements, so a rows × cols size
+// counts as rows × (cols + mtxRowCost) elements, and a size with no columns is bounded too.
+const mtxMaxElements = 1 << 26
+
+// mtxRowCost is the size of a slice header in float64 elements.
+const mtxRowCost = 3
+
+// ReadMatrixMarket reads a matrix in the Matrix Market exchange format.
+// The coordinate and array formats are supported with real, integer and pattern fields
+// and general, symmetric and skew-symmetric symmetry. Duplicate coordinate entries are summed.
+// Sizes of more than 1 << 26 elements, counting three for the overhead of each row, are rejected.
+func ReadMatrixMarket(r io.Reader) (*Matrix, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	line := 0
+
+	// nextLine returns the fields of the next line that is neither blank nor a comment
+	nextLine := func() ([]string, error) {
+		for scanner.Scan() {
+			line++
+			text := strings.TrimSpace(scanner.Text())
+			if text == "" || strings.HasPrefix(text, "%") {
+				continue
+			}
+			return strings.Fields(text), nil
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	// Parse the banner line
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.ErrUnexpectedEOF
+	}
+	line++
+	banner := strings.Fields(strings.ToLower(scanner.Text()))
+	if len(banner) != 5 || banner[0] != "%%matrixmarket" || banner[1] != "matrix" {
+		return nil, fmt.Errorf("matrix: mtx line 1: invalid Matrix Market banner")
+	}
+	format := MatrixMarketFormat(banner[2])
+	field := MatrixMarketField(banner[3])
+	symmetry := MatrixMarketSymmetry(banner[4])
+	if format != MatrixMarketCoordinate && format != MatrixMarketArray {
+		return nil, fmt.Errorf("matrix: mtx line 1: unsupported format %q", format)
+	}
+	if field != MatrixMarketReal && field != MatrixMarketInteger && field != MatrixMarketPattern {
+		return nil, fmt.Errorf("matrix: mtx line 1: unsupported field %q", field)
+	}
+	if symmetry != MatrixMarketGeneral && symmetry != MatrixMarketSymmetric && symmetry != MatrixMarketSkewSymmetric {
+		return nil, fmt.Errorf("matrix: mtx line 1: unsupported symmetry %q", symmetry)
+	}
+	if format == MatrixMarketArray && field == MatrixMarketPattern {
+		return nil, fmt.Errorf("matrix: mtx line 1: the array format cannot have a pattern field")
+	}
+
+	// Parse the size line
+	fields, err := nextLine()
+	if err != nil {
+		return nil, err
+	}
+	expected := 3
+	if format == MatrixMarketArray {
+		expected = 2
+	}
+	if len(fields) != expected {
+		return nil, fmt.Errorf("matrix: mtx line %d: expected %d size values, got %d", line, expected, len(fields))
+	}
+	size := make([]int, expected)
+	for k, f := range fields {
+		size[k], err = strconv.Atoi(f)
+		if err != nil || size[k] < 0 {
+			return nil, fmt.Errorf("matrix: mtx line %d: invalid size %q", line, f)
+		}
+	}
+	rows, cols := size[0], size[1]
+	if cols > mtxMaxElements || rows > mtxMaxElements/(cols+mtxRowCost) {
+		return nil, fmt.Errorf("matrix: mtx line %d: size %dx%d exceeds the limit of %d elements", line, rows, cols, mtxMaxElements)
+	}
+	if symmetry != MatrixMarketGeneral && rows != cols {
+		return nil, fmt.Errorf("matrix: mtx line %d: a %s matrix must be square", line, symmetry)
+	}
+
+	// parseValue parses a value of the declared field
+	parseValue := func(s string) (float64, error) {
+		if field == MatrixMarketInteger {
+			v, err := strconv.ParseInt(s, 10, 64)
+			return float64(v), err
+		}
+		return strconv.ParseFloat(s, 64)
+	}
+
+	result := &Matrix{
+		rows:    rows,
+		columns: cols,
+		values:  make([][]float64, rows),
+	}
+	for i := 0; i < rows; i++ {
+		result.values[i] = make([]float64, cols)
+	}
+
+	// set stores an entry and its mirror image for symmetric matrices
+	set := func(i, j int, v float64) {
+		result.values[i][j] += v
+		switch {
+		case i == j:
+		case symmetry == MatrixMarketSymmetric:
+			result.values[j][i] += v
+		case symmetry == MatrixMarketSkewSymmetric:
+			result.values[j][i] -= v
+		}
+	}
+
+	if format == MatrixMarketCoordinate {
+		// Read the listed entries
+		entries := size[2]
+		valueFields := 3
+		if field == MatrixMarketPattern {
+			valueFields = 2
+		}
+		for k := 0; k < entries; k++ {
+			fields, err := nextLine()
+			if err != nil {
+				return nil, err
+			}
+			if len(fields) != valueFields {
+				return nil, fmt.Errorf("matrix: mtx line %d: expected %d values, got %d", line, valueFields, len(fields))
+			}
+			i, errI := strconv.Atoi(fields[0])
+			j, errJ := strconv.Atoi(fields[1])
+			if errI != nil || errJ != nil || i < 1 || i > rows || j < 1 || j > cols {
+				return nil, fmt.Errorf("matrix: mtx line %d: invalid position (%s, %s)", line, fields[0], fields[1])
+			}
+			if (symmetry == MatrixMarketSymmetric && i < j) || (symmetry == MatrixMarketSkewSymmetric && i <= j) {
+				return nil, fmt.Errorf("matrix: mtx line %d: entry (%d, %d) is outside the stored triangle", line, i, j)
+			}
+			v := 1.0
+			if field != MatrixMarketPattern {
+				v, err = parseValue(fields[2])
+				if err != nil {
+					return nil, fmt.Errorf("matrix: mtx line %d: invalid value %q", line, fields[2])
+				}
+			}
+			set(i-1, j-1, v)
+		}
+	} else {
+		// Read the stored part of each column in order
+		for j := 0; j < cols; j++ {
+			start := 0
+			switch symmetry {
+			case MatrixMarketSymmetric:
+				start = j
+			case MatrixMarketSkewSymmetric:
+				start = j + 1
+			}
+			for i := start; i < rows; i++ {
+				fields, err := nextLine()
+				if err != nil {
+					return nil, err
+				}
+				if len(fields) != 1 {
+					return nil, fmt.Errorf("matrix: mtx line %d: expected 1 value, got %d", line, len(fields))
+				}
+				v, err := parseValue(fields[0])
+				if err != nil {
+					return nil, fmt.Errorf("matrix: mtx line %d: invalid value %q", line, fields[0])
+				}
+				set(i, j, v)
+			}
+		}
+	}
+
+	// Anything after the declared entries is an error
+	if _, err := nextLine(); err != io.ErrUnexpectedEOF {
+		if err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("matrix: mtx line %d: more entries than declared", line)
+	}
+
+	return result, nil
+}
+
+// WriteMatrixMarket writes the matrix in the Matrix Market exchange format.
+// It returns an error if the matrix doesn't have the requested symmetry, if an integer field is requested
+// for non-integer values, or if a pattern field is requested for the array format.
+func WriteMatrixMarket(w io.Writer, m Matrix, opts MatrixMarketOptions) error {
+	format, field, symmetry := opts.Format, opts.Field, opts.Symmetry
+	if format == "" {
+		format = MatrixMarketCoordinate
+	}
+	if field == "" {
+		field = MatrixMarketReal
+	}
+	if symmetry == "" {
+		symmetry = MatrixMarketGeneral
+	}
+
+	// Check if the options are valid for this matrix
+	if format != MatrixMarketCoordinate && format != MatrixMarketArray {
+		return fmt.Errorf("matrix: unsupported Matrix Market format %q", format)
+	}
+	if field != MatrixMarketReal && field != MatrixMarketInteger && field != MatrixMarketPattern {
+		return fmt.Errorf("matrix: unsupported Matrix Market field %q", field)
+	}
+	if format == MatrixMarketArray && field == MatrixMarketPattern {
+		return fmt.Errorf("matrix: the Matrix Market array format cannot have a pattern field")
+	}
+	switch symmetry {
+	case MatrixMarketGeneral:
+	case MatrixMarketSymmetric, MatrixMarketSkewSymmetric:
+		if m.rows != m.columns {
+			return fmt.Errorf("matrix: a %s matrix must be square", symmetry)
+		}
+		sign := 1.0
+		if symmetry == MatrixMarketSkewSymmetric {
+			sign = -1
+		}
+		for i := 0; i < m.rows; i++ {
+			for j := 0; j <= i; j++ {
+				if m.values[i][j] != sign*m.values[j][i] {
+					return fmt.Errorf("matrix: the matrix is not %s at (%d, %d)", symmetry, i+1, j+1)
+				}
+			}
+		}
+	default:
+		return fmt.Errorf("matrix: unsupported Matrix Market symmetry %q", symmetry)
+	}
+	if field == MatrixMarketInteger {
+		for i := 0; i < m.rows; i++ {
+			for j := 0; j < m.columns; j++ {
+				v := m.values[i][j]
+				if v != math.Trunc(v) || math.IsInf(v, 0) {
+					return fmt.Errorf("matrix: value at (%d, %d) is not an integer", i+1, j+1)
+				}
+			}
+		}
+	}
+
+	formatValue := func(v float64) string {
+		if field == MatrixMarketInteger {
+			return strconv.FormatFloat(v, 'f', 0, 64)
+		}
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+
+	// stored reports whether the entry at (i, j) belongs to the stored triangle
+	stored := func(i, j int) bool {
+		switch symmetry {
+		case MatrixMarketSymmetric:
+			return i >= j
+		case MatrixMarketSkewSymmetric:
+			return i > j
+		}
+		return true
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "%%%%MatrixMarket matrix %s %s %s\n", format, field, symmetry)
+
+	if format == MatrixMarketCoordinate {
+		// Collect the stored non-zero entries in column-major order
+		var entries []string
+		for j := 0; j < m.columns; j++ {
+			for i := 0; i < m.rows; i++ {
+				if !stored(i, j) || m.values[i][j] == 0 {
+					continue
+				}
+				entry := strconv.Itoa(i+1) + " " + strconv.Itoa(j+1)
+				if field != MatrixMarketPattern {
+					entry += " " + formatValue(m.values[i][j])
+				}
+				entries = append(entries, entry)
+			}
+		}
+		fmt.Fprintf(bw, "%d %d %d\n", m.rows, m.columns, len(entries))
+		for _, entry := range entries {
+			fmt.Fprintln(bw, entry)
+		}
+	} else {
+		// Write the stored part of each column in order
+		fmt.Fprintf(bw, "%d %d\n", m.rows, m.columns)
+		for j := 0; j < m.columns; j++ {
+			for i := 0; i < m.rows; i++ {
+				if stored(i, j) {
+					fmt.Fprintln(bw, formatValue(m.values[i][j]))
+				}
+			}
+		}
+	}
+
+	return bw.Flush()
+}
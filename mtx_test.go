@@ -0,0 +1,182 @@
+package matrix
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// TestReadMatrixMarket tests the ReadMatrixMarket function
+func TestReadMatrixMarket(t *testing.T) {
+	// Test case 1: General coordinate format with comments and a duplicate entry
+	input1 := "%%MatrixMarket matrix coordinate real general\n" +
+		"% a comment\n" +
+		"2 3 4\n" +
+		"1 1 1.5\n" +
+		"2 3 -2\n" +
+		"1 2 3e1\n" +
+		"2 3 1\n"
+
+	expected1 := &Matrix{
+		rows:    2,
+		columns: 3,
+		values: [][]float64{
+			{1.5, 30, 0},
+			{0, 0, -1},
+		},
+	}
+
+	result1, err := ReadMatrixMarket(strings.NewReader(input1))
+	if err != nil {
+		t.Fatalf("ReadMatrixMarket returned an error: %v", err)
+	}
+	if !matricesEqual(t, expected1, result1) {
+		t.Errorf("ReadMatrixMarket failed for general coordinate format")
+	}
+
+	// Test case 2: Symmetric pattern coordinate format
+	input2 := "%%MatrixMarket matrix coordinate pattern symmetric\n" +
+		"3 3 3\n" +
+		"1 1\n" +
+		"3 1\n" +
+		"3 2\n"
+
+	expected2 := &Matrix{
+		rows:    3,
+		columns: 3,
+		values: [][]float64{
+			{1, 0, 1},
+			{0, 0, 1},
+			{1, 1, 0},
+		},
+	}
+
+	result2, err := ReadMatrixMarket(strings.NewReader(input2))
+	if err != nil {
+		t.Fatalf("ReadMatrixMarket returned an error: %v", err)
+	}
+	if !matricesEqual(t, expected2, result2) {
+		t.Errorf("ReadMatrixMarket failed for symmetric pattern format")
+	}
+
+	// Test case 3: Skew-symmetric integer array format
+	input3 := "%%MatrixMarket matrix array integer skew-symmetric\n" +
+		"3 3\n" +
+		"1\n" +
+		"2\n" +
+		"3\n"
+
+	expected3 := &Matrix{
+		rows:    3,
+		columns: 3,
+		values: [][]float64{
+			{0, -1, -2},
+			{1, 0, -3},
+			{2, 3, 0},
+		},
+	}
+
+	result3, err := ReadMatrixMarket(strings.NewReader(input3))
+	if err != nil {
+		t.Fatalf("ReadMatrixMarket returned an error: %v", err)
+	}
+	if !matricesEqual(t, expected3, result3) {
+		t.Errorf("ReadMatrixMarket failed for skew-symmetric array format")
+	}
+
+	// Test case 4: Invalid inputs
+	invalid := []string{
+		"%%MatrixMarket matrix coordinate complex general\n1 1 1\n1 1 1 0\n",
+		"%%MatrixMarket matrix array pattern general\n1 1\n",
+		"%%MatrixMarket matrix coordinate real general\n2 2 2\n1 1 1\n",
+		"%%MatrixMarket matrix coordinate real general\n2 2 1\n3 1 1\n",
+		"%%MatrixMarket matrix coordinate real general\n2 2 1\n1 1 x\n",
+		"%%MatrixMarket matrix coordinate real symmetric\n2 2 1\n1 2 1\n",
+		"%%MatrixMarket matrix array real general\n1 1\n1\n2\n",
+		"1 1 1\n1 1 1\n",
+		"%%MatrixMarket matrix coordinate real general\n4611686018427387904 1 0\n",
+		"%%MatrixMarket matrix coordinate real general\n3037000500 3037000500 0\n",
+		"%%MatrixMarket matrix array real general\n4611686018427387904 0\n",
+		"%%MatrixMarket matrix coordinate real general\n67108864 0 0\n",
+		"%%MatrixMarket matrix coordinate real general\n67108864 1 0\n",
+	}
+	for _, input := range invalid {
+		if _, err := ReadMatrixMarket(strings.NewReader(input)); err == nil {
+			t.Errorf("ReadMatrixMarket should return an error for %q", input)
+		}
+	}
+}
+
+// TestWriteMatrixMarket tests the WriteMatrixMarket function
+func TestWriteMatrixMarket(t *testing.T) {
+	m := NewMatrix(3, 3, [][]float64{
+		{4, 1, 0},
+		{1, 0.5, 2},
+		{0, 2, 3},
+	})
+
+	// Test case 1: General coordinate format
+	var buf1 bytes.Buffer
+	if err := WriteMatrixMarket(&buf1, m, MatrixMarketOptions{}); err != nil {
+		t.Fatalf("WriteMatrixMarket returned an error: %v", err)
+	}
+	expected1 := "%%MatrixMarket matrix coordinate real general\n" +
+		"3 3 7\n" +
+		"1 1 4\n" +
+		"2 1 1\n" +
+		"1 2 1\n" +
+		"2 2 0.5\n" +
+		"3 2 2\n" +
+		"2 3 2\n" +
+		"3 3 3\n"
+	if buf1.String() != expected1 {
+		t.Errorf("WriteMatrixMarket failed: expected\n%s\ngot\n%s", expected1, buf1.String())
+	}
+
+	// Test case 2: Round trips through every supported combination
+	options := []MatrixMarketOptions{
+		{Format: MatrixMarketCoordinate, Symmetry: MatrixMarketSymmetric},
+		{Format: MatrixMarketArray},
+		{Format: MatrixMarketArray, Symmetry: MatrixMarketSymmetric},
+	}
+	for _, opts := range options {
+		var buf bytes.Buffer
+		if err := WriteMatrixMarket(&buf, m, opts); err != nil {
+			t.Fatalf("WriteMatrixMarket returned an error for %+v: %v", opts, err)
+		}
+		result, err := ReadMatrixMarket(&buf)
+		if err != nil {
+			t.Fatalf("ReadMatrixMarket returned an error for %+v: %v", opts, err)
+		}
+		if !matricesEqual(t, &m, result) {
+			t.Errorf("Matrix Market round trip failed for %+v", opts)
+		}
+	}
+
+	skew := NewMatrix(2, 2, [][]float64{
+		{0, -7},
+		{7, 0},
+	})
+	var buf2 bytes.Buffer
+	if err := WriteMatrixMarket(&buf2, skew, MatrixMarketOptions{Field: MatrixMarketInteger, Symmetry: MatrixMarketSkewSymmetric}); err != nil {
+		t.Fatalf("WriteMatrixMarket returned an error for skew-symmetric matrix: %v", err)
+	}
+	result2, err := ReadMatrixMarket(&buf2)
+	if err != nil {
+		t.Fatalf("ReadMatrixMarket returned an error: %v", err)
+	}
+	if !matricesEqual(t, &skew, result2) {
+		t.Errorf("Matrix Market round trip failed for skew-symmetric integer matrix")
+	}
+
+	// Test case 3: Options that don't fit the matrix
+	if err := WriteMatrixMarket(&bytes.Buffer{}, m, MatrixMarketOptions{Field: MatrixMarketInteger}); err == nil {
+		t.Errorf("WriteMatrixMarket should return an error for non-integer values in an integer field")
+	}
+	if err := WriteMatrixMarket(&bytes.Buffer{}, m, MatrixMarketOptions{Symmetry: MatrixMarketSkewSymmetric}); err == nil {
+		t.Errorf("WriteMatrixMarket should return an error for a matrix that isn't skew-symmetric")
+	}
+	if err := WriteMatrixMarket(&bytes.Buffer{}, m, MatrixMarketOptions{Format: MatrixMarketArray, Field: MatrixMarketPattern}); err == nil {
+		t.Errorf("WriteMatrixMarket should return an error for the array format with a pattern field")
+	}
+}
@@ -0,0 +1,195 @@
+package matrix
+
+import (
+	"runtime"
+	"sync"
+)
+
+// parallelThreshold is the number of multiply-adds (a.rows × a.columns × b.columns) below which
+// MultiplyMatricesParallel computes the product serially, since starting goroutines would cost more than it saves.
+const parallelThreshold = 1 << 15
+
+// MultiplyMatricesParallel multiplies two matrices using up to workers goroutines
+// and returns a pointer to the resulting matrix. A workers value of zero or less uses runtime.GOMAXPROCS(0).
+// Each goroutine computes a contiguous band of rows of the result, and every element is summed in the same
+// order as MultiplyMatrices, so the result is identical to MultiplyMatrices for any number of workers.
+// Returns nil if the matrices cannot be multiplied.
+func MultiplyMatricesParallel(a, b Matrix, workers int) *Matrix {
+	// Check if matrices can be multiplied
+	if a.columns != b.rows {
+		return nil
+	}
+
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > a.rows {
+		workers = a.rows
+	}
+
+	// Create a new matrix with dimensions (a.rows × b.columns)
+	result := zeroMatrix(a.rows, b.columns)
+
+	// Small products are faster without the goroutines
+	if workers <= 1 || a.rows*a.columns*b.columns < parallelThreshold {
+		multiplyRows(a, b, result, 0, a.rows)
+		return &result
+	}
+
+	// Split the rows into one contiguous band per worker
+	var wg sync.WaitGroup
+	band := (a.rows + workers - 1) / workers
+	for lo := 0; lo < a.rows; lo += band {
+		hi := min(lo+band, a.rows)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			multiplyRows(a, b, result, lo, hi)
+		}()
+	}
+	wg.Wait()
+
+	return &result
+}
+
+// multiplyRows computes the rows [lo, hi) of a × b into result, summing each dot product in the same order as MultiplyMatrices.
+func multiplyRows(a, b, result Matrix, lo, hi int) {
+	for i := lo; i < hi; i++ {
+		for j := 0; j < b.columns; j++ {
+			// Calculate the dot product of row i from matrix a and column j from matrix b
+			sum := 0.0
+			for k := 0; k < a.columns; k++ {
+				sum += a.values[i][k] * b.values[k][j]
+			}
+			result.values[i][j] = sum
+		}
+	}
+}
+
+// Block sizes for MultiplyMatricesBlocked. A packed panel of B holds blockK × blockJ values (256 KiB),
+// and the blockJ elements of a result row that a panel updates fit comfortably in the L1 cache.
+const (
+	blockK = 128
+	blockJ = 256
+)
+
+// MultiplyMatricesBlocked multiplies two matrices with a cache-blocked kernel and returns a pointer to the resulting matrix.
+// B is copied one blockK × blockJ panel at a time into contiguous memory, and each row of A is multiplied against
+// the panel in i-k-j order, so the inner loop walks both the panel and the result row sequentially.
+// The result matches MultiplyMatrices up to rounding.
+// Returns nil if the matrices cannot be multiplied.
+func MultiplyMatricesBlocked(a, b Matrix) *Matrix {
+	// Check if matrices can be multiplied
+	if a.columns != b.rows {
+		return nil
+	}
+
+	// Create a new matrix with dimensions (a.rows × b.columns)
+	result := zeroMatrix(a.rows, b.columns)
+
+	panel := make([]float64, blockK*blockJ)
+	for jj := 0; jj < b.columns; jj += blockJ {
+		jEnd := min(jj+blockJ, b.columns)
+		width := jEnd - jj
+
+		for kk := 0; kk < a.columns; kk += blockK {
+			kEnd := min(kk+blockK, a.columns)
+
+			// Pack the panel B[kk:kEnd][jj:jEnd] into contiguous rows of the given width
+			for k := kk; k < kEnd; k++ {
+				copy(panel[(k-kk)*width:(k-kk+1)*width], b.values[k][jj:jEnd])
+			}
+
+			// Accumulate the contribution of the panel into every row of the result
+			for i := 0; i < a.rows; i++ {
+				out := result.values[i][jj:jEnd]
+				rowA := a.values[i][kk:kEnd]
+				for k, aik := range rowA {
+					row := panel[k*width : (k+1)*width]
+					for j, bkj := range row {
+						out[j] += aik * bkj
+					}
+				}
+			}
+		}
+	}
+
+	return &result
+}
+
+// defaultStrassenCrossover is the crossover size MultiplyStrassen uses when it is given a crossover of zero or less.
+// Below 256 the extra additions and allocations of a level of recursion cost more than the multiplications it saves.
+const defaultStrassenCrossover = 256
+
+// MultiplyStrassen multiplies two n × n matrices with Strassen's algorithm and returns a pointer to the resulting matrix.
+// Subproblems of size crossover or smaller are multiplied with MultiplyMatricesBlocked; a crossover of zero or less
+// uses a default of 256. The matrices are padded with zeros to the smallest size that halves evenly down to
+// the crossover, which for large matrices is much less padding than the next power of two.
+// With the default crossover, MultiplyStrassen only beats MultiplyMatricesBlocked from about 1024 × 1024 up,
+// by around 15% at that size, so smaller products are better left to MultiplyMatricesBlocked.
+// Strassen's algorithm trades multiplications for additions, so the result has a larger rounding error
+// than MultiplyMatrices, growing by up to a factor of 12 with each level of recursion.
+// Returns nil if the matrices are not square or have different sizes.
+func MultiplyStrassen(a, b Matrix, crossover int) *Matrix {
+	// Check if the matrices are square and the same size
+	if a.rows != a.columns || b.rows != b.columns || a.rows != b.rows {
+		return nil
+	}
+	if crossover <= 0 {
+		crossover = defaultStrassenCrossover
+	}
+
+	n := a.rows
+	if n <= crossover {
+		return MultiplyMatricesBlocked(a, b)
+	}
+
+	// Find the smallest size of the form m·2^k with m <= crossover that holds the matrices
+	levels := 0
+	for (n+(1<<levels)-1)>>levels > crossover {
+		levels++
+	}
+	size := ((n + (1 << levels) - 1) >> levels) << levels
+
+	// Pad both matrices with zeros to the new size
+	paddedA := zeroMatrix(size, size)
+	paddedB := zeroMatrix(size, size)
+	for i := 0; i < n; i++ {
+		copy(paddedA.values[i], a.values[i])
+		copy(paddedB.values[i], b.values[i])
+	}
+
+	product := strassen(paddedA, paddedB, crossover)
+	return Clone(*Slice(product, 0, n, 0, n))
+}
+
+// strassen multiplies two square matrices whose size is at most crossover times a power of two.
+func strassen(a, b Matrix, crossover int) Matrix {
+	if a.rows <= crossover {
+		return *MultiplyMatricesBlocked(a, b)
+	}
+
+	// Split both matrices into quadrants that share their storage
+	h := a.rows / 2
+	a11, a12 := *Slice(a, 0, h, 0, h), *Slice(a, 0, h, h, a.rows)
+	a21, a22 := *Slice(a, h, a.rows, 0, h), *Slice(a, h, a.rows, h, a.rows)
+	b11, b12 := *Slice(b, 0, h, 0, h), *Slice(b, 0, h, h, b.rows)
+	b21, b22 := *Slice(b, h, b.rows, 0, h), *Slice(b, h, b.rows, h, b.rows)
+
+	// Compute Strassen's seven products
+	m1 := strassen(*AddMatrices(a11, a22), *AddMatrices(b11, b22), crossover)
+	m2 := strassen(*AddMatrices(a21, a22), b11, crossover)
+	m3 := strassen(a11, *SubtractMatrices(b12, b22), crossover)
+	m4 := strassen(a22, *SubtractMatrices(b21, b11), crossover)
+	m5 := strassen(*AddMatrices(a11, a12), b22, crossover)
+	m6 := strassen(*SubtractMatrices(a21, a11), *AddMatrices(b11, b12), crossover)
+	m7 := strassen(*SubtractMatrices(a12, a22), *AddMatrices(b21, b22), crossover)
+
+	// Combine the products into the quadrants of the result
+	c11 := AddMatrices(*SubtractMatrices(*AddMatrices(m1, m4), m5), m7)
+	c12 := AddMatrices(m3, m5)
+	c21 := AddMatrices(m2, m4)
+	c22 := AddMatrices(*AddMatrices(*SubtractMatrices(m1, m2), m3), m6)
+
+	return *Block([][]Matrix{{*c11, *c12}, {*c21, *c22}})
+}
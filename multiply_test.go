@@ -0,0 +1,177 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// randomTestMatrix returns a rows × cols matrix of values in [-1, 1) drawn from a fixed seed.
+func randomTestMatrix(rows, cols int, seed uint64) Matrix {
+	return *RandomUniform(rand.New(rand.NewPCG(seed, seed)), rows, cols, -1, 1)
+}
+
+// TestMultiplyMatricesParallel tests the MultiplyMatricesParallel function
+func TestMultiplyMatricesParallel(t *testing.T) {
+	// Test case 1: Small matrices take the serial path
+	a := MustParse("[1 2 3; 4 5 6]")
+	b := MustParse("[7 8; 9 10; 11 12]")
+	if !matricesEqual(t, MultiplyMatrices(a, b), MultiplyMatricesParallel(a, b, 4)) {
+		t.Errorf("MultiplyMatricesParallel failed for small matrices")
+	}
+
+	// Test case 2: Results are identical to MultiplyMatrices for any number of workers
+	a = randomTestMatrix(97, 64, 1)
+	b = randomTestMatrix(64, 53, 2)
+	expected := MultiplyMatrices(a, b)
+	for _, workers := range []int{0, 1, 2, 3, 8, 200} {
+		if !matricesEqual(t, expected, MultiplyMatricesParallel(a, b, workers)) {
+			t.Errorf("MultiplyMatricesParallel with %d workers does not match MultiplyMatrices", workers)
+		}
+	}
+
+	// Test case 3: Incompatible dimensions
+	if MultiplyMatricesParallel(a, a, 2) != nil {
+		t.Errorf("MultiplyMatricesParallel should return nil for incompatible dimensions")
+	}
+}
+
+// maxAbsDifference returns the largest absolute difference between corresponding elements of two matrices of the same size.
+func maxAbsDifference(a, b Matrix) float64 {
+	largest := 0.0
+	for i := 0; i < a.rows; i++ {
+		for j := 0; j < a.columns; j++ {
+			largest = max(largest, math.Abs(a.values[i][j]-b.values[i][j]))
+		}
+	}
+	return largest
+}
+
+// TestMultiplyMatricesBlocked tests the MultiplyMatricesBlocked function
+func TestMultiplyMatricesBlocked(t *testing.T) {
+	// Test case 1: Small matrices fit in a single panel
+	a := MustParse("[1 2 3; 4 5 6]")
+	b := MustParse("[7 8; 9 10; 11 12]")
+	if !matricesEqual(t, MultiplyMatrices(a, b), MultiplyMatricesBlocked(a, b)) {
+		t.Errorf("MultiplyMatricesBlocked failed for small matrices")
+	}
+
+	// Test case 2: Dimensions that are not multiples of the block sizes
+	a = randomTestMatrix(37, blockK*2+5, 3)
+	b = randomTestMatrix(blockK*2+5, blockJ+17, 4)
+	result := MultiplyMatricesBlocked(a, b)
+	if result == nil || result.rows != 37 || result.columns != blockJ+17 {
+		t.Fatalf("MultiplyMatricesBlocked returned the wrong shape")
+	}
+	if diff := maxAbsDifference(*MultiplyMatrices(a, b), *result); diff > 1e-12 {
+		t.Errorf("MultiplyMatricesBlocked differs from MultiplyMatrices by %g", diff)
+	}
+
+	// Test case 3: Incompatible dimensions
+	if MultiplyMatricesBlocked(a, a) != nil {
+		t.Errorf("MultiplyMatricesBlocked should return nil for incompatible dimensions")
+	}
+}
+
+// benchmarkSizes are the square matrix sizes used by the multiplication benchmarks.
+var benchmarkSizes = []int{64, 256, 1024}
+
+// benchmarkMultiply runs a multiplication function on square matrices of each benchmark size.
+func benchmarkMultiply(b *testing.B, multiply func(a, b Matrix) *Matrix) {
+	for _, n := range benchmarkSizes {
+		x := randomTestMatrix(n, n, 1)
+		y := randomTestMatrix(n, n, 2)
+		b.Run(fmt.Sprintf("%dx%d", n, n), func(b *testing.B) {
+			for b.Loop() {
+				multiply(x, y)
+			}
+		})
+	}
+}
+
+// BenchmarkMultiplyMatrices benchmarks the naive MultiplyMatrices function
+func BenchmarkMultiplyMatrices(b *testing.B) {
+	benchmarkMultiply(b, MultiplyMatrices)
+}
+
+// BenchmarkMultiplyMatricesBlocked benchmarks the MultiplyMatricesBlocked function
+func BenchmarkMultiplyMatricesBlocked(b *testing.B) {
+	benchmarkMultiply(b, MultiplyMatricesBlocked)
+}
+
+// BenchmarkMultiplyMatricesParallel benchmarks the MultiplyMatricesParallel function with the default number of workers
+func BenchmarkMultiplyMatricesParallel(b *testing.B) {
+	benchmarkMultiply(b, func(x, y Matrix) *Matrix { return MultiplyMatricesParallel(x, y, 0) })
+}
+
+// strassenErrorBound returns the number of levels of recursion MultiplyStrassen uses for n × n matrices
+// and a bound on the largest difference between its product and that of MultiplyMatrices for matrices
+// with elements of magnitude at most 1. Higham's bound for Strassen's algorithm with k levels above subproblems
+// of size n0 is (12^k (n0² + 5·n0) - 5·2^k·n0)·u, to which the n·u bound of MultiplyMatrices is added.
+func strassenErrorBound(n, crossover int) (int, float64) {
+	levels := 0
+	for (n+(1<<levels)-1)>>levels > crossover {
+		levels++
+	}
+	n0 := float64((n + (1 << levels) - 1) >> levels)
+	size := n0 * math.Pow(2, float64(levels))
+	growth := math.Pow(12, float64(levels))
+	u := math.Pow(2, -53)
+	return levels, (growth*(n0*n0+5*n0) - 5*size + float64(n)) * u
+}
+
+// TestMultiplyStrassen tests the MultiplyStrassen function
+func TestMultiplyStrassen(t *testing.T) {
+	// Test case 1: Matrices at or below the crossover use the blocked kernel
+	a := MustParse("[1 2; 3 4]")
+	b := MustParse("[5 6; 7 8]")
+	if !matricesEqual(t, MultiplyMatrices(a, b), MultiplyStrassen(a, b, 0)) {
+		t.Errorf("MultiplyStrassen failed for small matrices")
+	}
+
+	// Test case 2: Integer matrices are exact, including sizes that need padding
+	for _, n := range []int{8, 13, 33} {
+		x := zeroMatrix(n, n)
+		y := zeroMatrix(n, n)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				x.values[i][j] = float64((i*7+j*3)%11 - 5)
+				y.values[i][j] = float64((i*5+j*2)%9 - 4)
+			}
+		}
+		if !matricesEqual(t, MultiplyMatrices(x, y), MultiplyStrassen(x, y, 4)) {
+			t.Errorf("MultiplyStrassen failed for %dx%d integer matrices", n, n)
+		}
+	}
+
+	// Test case 3: The rounding error relative to MultiplyMatrices stays within the bound for the recursion depth
+	for _, crossover := range []int{16, 64} {
+		x := randomTestMatrix(300, 300, 5)
+		y := randomTestMatrix(300, 300, 6)
+		expected := MultiplyMatrices(x, y)
+		result := MultiplyStrassen(x, y, crossover)
+		if result == nil || result.rows != 300 || result.columns != 300 {
+			t.Fatalf("MultiplyStrassen returned the wrong shape with crossover %d", crossover)
+		}
+		levels, bound := strassenErrorBound(300, crossover)
+		diff := maxAbsDifference(*expected, *result)
+		t.Logf("MultiplyStrassen with crossover %d and %d levels differs by %g (bound %g)", crossover, levels, diff, bound)
+		if diff > bound {
+			t.Errorf("MultiplyStrassen with crossover %d differs from MultiplyMatrices by %g, more than the bound %g", crossover, diff, bound)
+		}
+	}
+
+	// Test case 4: Non-square or mismatched matrices
+	if MultiplyStrassen(MustParse("[1 2 3; 4 5 6]"), MustParse("[1 2; 3 4; 5 6]"), 0) != nil {
+		t.Errorf("MultiplyStrassen should return nil for non-square matrices")
+	}
+	if MultiplyStrassen(a, IdentityMatrix(3), 0) != nil {
+		t.Errorf("MultiplyStrassen should return nil for matrices of different sizes")
+	}
+}
+
+// BenchmarkMultiplyStrassen benchmarks the MultiplyStrassen function with the default crossover
+func BenchmarkMultiplyStrassen(b *testing.B) {
+	benchmarkMultiply(b, func(x, y Matrix) *Matrix { return MultiplyStrassen(x, y, 0) })
+}
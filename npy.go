@@ -0,0 +1,250 @@
+package matrix
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// NPYDType is the NumPy data type used for the elements of a .npy file.
+type NPYDType string
+
+const (
+	// NPYFloat64 stores little-endian 64-bit floats. Round trips are bit-exact.
+	NPYFloat64 NPYDType = "<f8"
+	// NPYFloat32 stores little-endian 32-bit floats. Values are rounded to the nearest float32 when writing.
+	NPYFloat32 NPYDType = "<f4"
+	// NPYInt64 stores little-endian 64-bit integers. Writing fails for values that are not integers.
+	NPYInt64 NPYDType = "<i8"
+)
+
+// NPYOptions configures WriteNPY. The zero value writes float64 elements in C (row-major) order.
+type NPYOptions struct {
+	DType NPYDType
+	// Order is RowMajor for C order or ColumnMajor for Fortran order.
+	Order Order
+}
+
+// npyMagic is the prefix of every .npy file.
+const npyMagic = "\x93NUMPY"
+
+var (
+	npyDescrPattern   = regexp.MustCompile(`'descr'\s*:\s*'([^']*)'`)
+	npyFortranPattern = regexp.MustCompile(`'fortran_order'\s*:\s*(True|False)`)
+	npyShapePattern   = regexp.MustCompile(`'shape'\s*:\s*\(([^)]*)\)`)
+)
+
+// ReadNPY reads a matrix from NumPy's .npy format. Files of version 1, 2 and 3 are accepted,
+// with float64, float32 or int64 elements of either byte order, in C or Fortran order.
+// A one-dimensional array is read as a single row and a zero-dimensional array as a 1x1 matrix.
+// An array with no columns and more than MaxEmptyRows rows is rejected.
+func ReadNPY(r io.Reader) (*Matrix, error) {
+	br := bufio.NewReader(r)
+
+	// Read the magic string and version
+	prefix := make([]byte, len(npyMagic)+2)
+	if _, err := io.ReadFull(br, prefix); err != nil {
+		return nil, err
+	}
+	if string(prefix[:len(npyMagic)]) != npyMagic {
+		return nil, errors.New("matrix: npy data has an invalid magic string")
+	}
+
+	// Read the header length, which is 2 bytes in version 1 and 4 bytes in later versions
+	var headerLen int
+	switch prefix[len(npyMagic)] {
+	case 1:
+		var n uint16
+		if err := binary.Read(br, binary.LittleEndian, &n); err != nil {
+			return nil, err
+		}
+		headerLen = int(n)
+	case 2, 3:
+		var n uint32
+		if err := binary.Read(br, binary.LittleEndian, &n); err != nil {
+			return nil, err
+		}
+		if n > 1<<24 {
+			return nil, fmt.Errorf("matrix: npy header length %d is too large", n)
+		}
+		headerLen = int(n)
+	default:
+		return nil, fmt.Errorf("matrix: unsupported npy version %d.%d", prefix[len(npyMagic)], prefix[len(npyMagic)+1])
+	}
+
+	header := make([]byte, headerLen)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return nil, err
+	}
+
+	// Parse the header dictionary
+	descr := npyDescrPattern.FindSubmatch(header)
+	fortran := npyFortranPattern.FindSubmatch(header)
+	shape := npyShapePattern.FindSubmatch(header)
+	if descr == nil || fortran == nil || shape == nil {
+		return nil, fmt.Errorf("matrix: invalid npy header %q", strings.TrimSpace(string(header)))
+	}
+
+	var order binary.ByteOrder
+	switch string(descr[1][:1]) {
+	case "<":
+		order = binary.LittleEndian
+	case ">":
+		order = binary.BigEndian
+	default:
+		return nil, fmt.Errorf("matrix: unsupported npy dtype %q", descr[1])
+	}
+	kind := string(descr[1][1:])
+	var size int
+	switch kind {
+	case "f8", "i8":
+		size = 8
+	case "f4":
+		size = 4
+	default:
+		return nil, fmt.Errorf("matrix: unsupported npy dtype %q", descr[1])
+	}
+
+	var dims []int
+	for _, d := range strings.Split(string(shape[1]), ",") {
+		d = strings.TrimSpace(d)
+		if d == "" {
+			continue
+		}
+		n, err := strconv.Atoi(d)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("matrix: invalid npy shape (%s)", shape[1])
+		}
+		dims = append(dims, n)
+	}
+
+	rows, cols := 1, 1
+	switch len(dims) {
+	case 0:
+	case 1:
+		cols = dims[0]
+	case 2:
+		rows, cols = dims[0], dims[1]
+	default:
+		return nil, fmt.Errorf("matrix: npy array has %d dimensions, expected at most 2", len(dims))
+	}
+
+	if cols != 0 && rows > math.MaxInt/cols/size {
+		return nil, fmt.Errorf("matrix: npy shape (%s) is too large", shape[1])
+	}
+	if tooManyEmptyRows(rows, cols) {
+		return nil, fmt.Errorf("matrix: npy shape (%s) exceeds the limit of %d rows without columns", shape[1], MaxEmptyRows)
+	}
+
+	// Read the elements in their stored order. The slice grows with the data actually read,
+	// so a corrupted shape cannot force a large allocation up front.
+	flat := make([]float64, 0, min(rows*cols, 1<<16))
+	buf := make([]byte, size)
+	for k := 0; k < rows*cols; k++ {
+		if _, err := io.ReadFull(br, buf); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+
+		switch kind {
+		case "f8":
+			flat = append(flat, math.Float64frombits(order.Uint64(buf)))
+		case "f4":
+			flat = append(flat, float64(math.Float32frombits(order.Uint32(buf))))
+		case "i8":
+			flat = append(flat, float64(int64(order.Uint64(buf))))
+		}
+	}
+
+	layout := RowMajor
+	if string(fortran[1]) == "True" {
+		layout = ColumnMajor
+	}
+	return Reshape(NewMatrix(1, len(flat), [][]float64{flat}), rows, cols, layout), nil
+}
+
+// WriteNPY writes the matrix in NumPy's .npy format as a two-dimensional array.
+// Version 1 of the format is used unless the header is too long for it.
+// It returns an error for a matrix with no columns and more than MaxEmptyRows rows, which ReadNPY would reject.
+func WriteNPY(w io.Writer, m Matrix, opts NPYOptions) error {
+	// Check if ReadNPY would accept the shape
+	if tooManyEmptyRows(m.rows, m.columns) {
+		return fmt.Errorf("matrix: %dx0 matrix exceeds the limit of %d rows without columns", m.rows, MaxEmptyRows)
+	}
+
+	dtype := opts.DType
+	if dtype == "" {
+		dtype = NPYFloat64
+	}
+
+	// Check if the values can be stored with the requested type
+	switch dtype {
+	case NPYFloat64, NPYFloat32:
+	case NPYInt64:
+		for i := 0; i < m.rows; i++ {
+			for j := 0; j < m.columns; j++ {
+				v := m.values[i][j]
+				if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+					return fmt.Errorf("matrix: value at (%d, %d) cannot be stored as int64", i+1, j+1)
+				}
+			}
+		}
+	default:
+		return fmt.Errorf("matrix: unsupported npy dtype %q", dtype)
+	}
+
+	// Build the header, padded with spaces so the data starts on a 64-byte boundary
+	fortran := "False"
+	if opts.Order == ColumnMajor {
+		fortran = "True"
+	}
+	dict := fmt.Sprintf("{'descr': '%s', 'fortran_order': %s, 'shape': (%d, %d), }", dtype, fortran, m.rows, m.columns)
+
+	var out bytes.Buffer
+	out.WriteString(npyMagic)
+	prefixLen := len(npyMagic) + 2 + 2
+	version := byte(1)
+	if len(dict)+1+prefixLen+64 > math.MaxUint16 {
+		version = 2
+		prefixLen += 2
+	}
+	padding := 64 - (prefixLen+len(dict)+1)%64
+	if padding == 64 {
+		padding = 0
+	}
+	headerLen := len(dict) + padding + 1
+
+	out.Write([]byte{version, 0})
+	if version == 1 {
+		binary.Write(&out, binary.LittleEndian, uint16(headerLen))
+	} else {
+		binary.Write(&out, binary.LittleEndian, uint32(headerLen))
+	}
+	out.WriteString(dict)
+	out.WriteString(strings.Repeat(" ", padding))
+	out.WriteByte('\n')
+
+	// Write the elements in the requested order
+	for _, v := range Flatten(m, opts.Order) {
+		switch dtype {
+		case NPYFloat64:
+			out.Write(binary.LittleEndian.AppendUint64(nil, math.Float64bits(v)))
+		case NPYFloat32:
+			out.Write(binary.LittleEndian.AppendUint32(nil, math.Float32bits(float32(v))))
+		case NPYInt64:
+			out.Write(binary.LittleEndian.AppendUint64(nil, uint64(int64(v))))
+		}
+	}
+
+	_, err := out.WriteTo(w)
+	return err
+}
@@ -0,0 +1,162 @@
+package matrix
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"strings"
+	"testing"
+)
+
+// npyFile builds the bytes of a version 1 .npy file with the given header dictionary and data.
+func npyFile(dict string, data []byte) []byte {
+	header := dict + strings.Repeat(" ", 63-(10+len(dict))%64) + "\n"
+	out := []byte(npyMagic + "\x01\x00")
+	out = binary.LittleEndian.AppendUint16(out, uint16(len(header)))
+	out = append(out, header...)
+	return append(out, data...)
+}
+
+// TestReadNPY tests the ReadNPY function
+func TestReadNPY(t *testing.T) {
+	// Test case 1: A Fortran-ordered big-endian float64 array
+	var data1 []byte
+	for _, v := range []float64{1, 4, 2, 5, 3, 6} {
+		data1 = binary.BigEndian.AppendUint64(data1, math.Float64bits(v))
+	}
+	input1 := npyFile("{'descr': '>f8', 'fortran_order': True, 'shape': (2, 3), }", data1)
+
+	expected1 := &Matrix{
+		rows:    2,
+		columns: 3,
+		values: [][]float64{
+			{1, 2, 3},
+			{4, 5, 6},
+		},
+	}
+
+	result1, err := ReadNPY(bytes.NewReader(input1))
+	if err != nil {
+		t.Fatalf("ReadNPY returned an error: %v", err)
+	}
+	if !matricesEqual(t, expected1, result1) {
+		t.Errorf("ReadNPY failed for a Fortran-ordered array")
+	}
+
+	// Test case 2: A one-dimensional int64 array is read as a single row
+	var data2 []byte
+	for _, v := range []int64{-1, 0, 7} {
+		data2 = binary.LittleEndian.AppendUint64(data2, uint64(v))
+	}
+	input2 := npyFile("{'descr': '<i8', 'fortran_order': False, 'shape': (3,), }", data2)
+
+	expected2 := &Matrix{
+		rows:    1,
+		columns: 3,
+		values:  [][]float64{{-1, 0, 7}},
+	}
+
+	result2, err := ReadNPY(bytes.NewReader(input2))
+	if err != nil {
+		t.Fatalf("ReadNPY returned an error: %v", err)
+	}
+	if !matricesEqual(t, expected2, result2) {
+		t.Errorf("ReadNPY failed for a one-dimensional array")
+	}
+
+	// Test case 3: Invalid inputs
+	invalid := [][]byte{
+		[]byte("NUMPY"),
+		npyFile("{'descr': '<f8', 'fortran_order': False, 'shape': (2, 2), }", data1[:24]),
+		npyFile("{'descr': '<c16', 'fortran_order': False, 'shape': (1, 1), }", data1),
+		npyFile("{'descr': '<f8', 'fortran_order': False, 'shape': (1, 1, 1), }", data1),
+		npyFile("{'descr': '<f8', 'shape': (1, 1), }", data1),
+		npyFile("{'descr': '<f8', 'fortran_order': False, 'shape': (4000000000, 0), }", nil),
+	}
+	for _, input := range invalid {
+		if _, err := ReadNPY(bytes.NewReader(input)); err == nil {
+			t.Errorf("ReadNPY should return an error for %q", input)
+		}
+	}
+}
+
+// TestWriteNPY tests the WriteNPY function
+func TestWriteNPY(t *testing.T) {
+	m := NewMatrix(2, 3, [][]float64{
+		{1.0 / 3, math.Copysign(0, -1), math.NaN()},
+		{math.Inf(-1), 5e-324, math.MaxFloat64},
+	})
+
+	// Test case 1: The header is aligned and the data starts on a 64-byte boundary
+	var buf1 bytes.Buffer
+	if err := WriteNPY(&buf1, m, NPYOptions{}); err != nil {
+		t.Fatalf("WriteNPY returned an error: %v", err)
+	}
+	out := buf1.Bytes()
+	headerLen := int(binary.LittleEndian.Uint16(out[8:10]))
+	if (10+headerLen)%64 != 0 || out[10+headerLen-1] != '\n' {
+		t.Errorf("WriteNPY wrote a misaligned header of length %d", headerLen)
+	}
+	if !bytes.Contains(out[:10+headerLen], []byte("'shape': (2, 3)")) || len(out) != 10+headerLen+6*8 {
+		t.Errorf("WriteNPY wrote an unexpected file: %q", out)
+	}
+
+	// Test case 2: float64 round trips are bit-exact in both orders
+	for _, order := range []Order{RowMajor, ColumnMajor} {
+		var buf bytes.Buffer
+		if err := WriteNPY(&buf, m, NPYOptions{Order: order}); err != nil {
+			t.Fatalf("WriteNPY returned an error: %v", err)
+		}
+		result, err := ReadNPY(&buf)
+		if err != nil {
+			t.Fatalf("ReadNPY returned an error: %v", err)
+		}
+		for i := 0; i < m.rows; i++ {
+			for j := 0; j < m.columns; j++ {
+				if math.Float64bits(result.values[i][j]) != math.Float64bits(m.values[i][j]) {
+					t.Errorf("npy round trip changed the value at [%d][%d]: expected %v, got %v", i, j, m.values[i][j], result.values[i][j])
+				}
+			}
+		}
+	}
+
+	// Test case 3: float32 and int64 round trips
+	small := NewMatrix(2, 2, [][]float64{
+		{1, -2},
+		{0.5, 1 << 40},
+	})
+	for _, dtype := range []NPYDType{NPYFloat32, NPYInt64} {
+		if dtype == NPYInt64 {
+			small.values[1][0] = 3
+		}
+		var buf bytes.Buffer
+		if err := WriteNPY(&buf, small, NPYOptions{DType: dtype}); err != nil {
+			t.Fatalf("WriteNPY returned an error for %s: %v", dtype, err)
+		}
+		result, err := ReadNPY(&buf)
+		if err != nil {
+			t.Fatalf("ReadNPY returned an error for %s: %v", dtype, err)
+		}
+		if !matricesEqual(t, &small, result) {
+			t.Errorf("npy round trip failed for %s", dtype)
+		}
+	}
+
+	// Test case 4: Values that cannot be stored as int64
+	if err := WriteNPY(&bytes.Buffer{}, m, NPYOptions{DType: NPYInt64}); err == nil {
+		t.Errorf("WriteNPY should return an error for non-integer values with int64 dtype")
+	}
+
+	// Test case 5: Matrices with no columns round-trip up to MaxEmptyRows rows
+	var buf5 bytes.Buffer
+	empty := zeroMatrix(2000000, 0)
+	if err := WriteNPY(&buf5, empty, NPYOptions{}); err != nil {
+		t.Fatalf("WriteNPY returned an error for a 2000000x0 matrix: %v", err)
+	}
+	if result, err := ReadNPY(&buf5); err != nil || result.rows != 2000000 || result.columns != 0 {
+		t.Errorf("npy round trip failed for a 2000000x0 matrix: %v", err)
+	}
+	if err := WriteNPY(&bytes.Buffer{}, Matrix{rows: MaxEmptyRows + 1}, NPYOptions{}); err == nil {
+		t.Errorf("WriteNPY should return an error for more than MaxEmptyRows rows without columns")
+	}
+}
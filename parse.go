@@ -0,0 +1,98 @@
+package matrix
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Parse builds a matrix from a MATLAB-style literal such as "[1 2 3; 4 5 6]".
+// The surrounding brackets are optional. Columns are separated by commas or spaces and rows by semicolons
+// or newlines; empty rows are ignored. Each element is a number as accepted by strconv.ParseFloat,
+// including scientific notation, Inf and NaN, or a simple fraction such as 1/3.
+func Parse(s string) (*Matrix, error) {
+	// Strip the optional brackets
+	body := strings.TrimSpace(s)
+	if strings.HasPrefix(body, "[") {
+		if !strings.HasSuffix(body, "]") {
+			return nil, fmt.Errorf("matrix: parse: missing closing bracket")
+		}
+		body = body[1 : len(body)-1]
+	} else if strings.HasSuffix(body, "]") {
+		return nil, fmt.Errorf("matrix: parse: missing opening bracket")
+	}
+
+	// Split the literal into rows
+	lines := strings.FieldsFunc(body, func(r rune) bool {
+		return r == ';' || r == '\n'
+	})
+
+	values := [][]float64{}
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		// Split the row into elements, rejecting empty elements between commas
+		var tokens []string
+		for _, part := range strings.Split(line, ",") {
+			fields := strings.Fields(part)
+			if len(fields) == 0 {
+				return nil, fmt.Errorf("matrix: parse row %d: empty element", len(values)+1)
+			}
+			tokens = append(tokens, fields...)
+		}
+
+		row := make([]float64, len(tokens))
+		for j, token := range tokens {
+			v, err := parseElement(token)
+			if err != nil {
+				return nil, fmt.Errorf("matrix: parse row %d, column %d: invalid element %q", len(values)+1, j+1, token)
+			}
+			row[j] = v
+		}
+
+		// Check if the row has the same number of columns as the previous ones
+		if len(values) > 0 && len(row) != len(values[0]) {
+			return nil, fmt.Errorf("matrix: parse row %d: has %d columns, expected %d", len(values)+1, len(row), len(values[0]))
+		}
+		values = append(values, row)
+	}
+
+	columns := 0
+	if len(values) > 0 {
+		columns = len(values[0])
+	}
+
+	result := &Matrix{
+		rows:    len(values),
+		columns: columns,
+		values:  values,
+	}
+	return result, nil
+}
+
+// parseElement parses a single number or a fraction of two numbers.
+func parseElement(token string) (float64, error) {
+	numerator, denominator, isFraction := strings.Cut(token, "/")
+	n, err := strconv.ParseFloat(numerator, 64)
+	if err != nil || !isFraction {
+		return n, err
+	}
+
+	d, err := strconv.ParseFloat(denominator, 64)
+	if err != nil {
+		return 0, err
+	}
+	return n / d, nil
+}
+
+// MustParse is like Parse but panics if the literal cannot be parsed.
+// It simplifies building matrices in tests and from trusted constants.
+func MustParse(s string) Matrix {
+	m, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return *m
+}
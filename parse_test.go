@@ -0,0 +1,89 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+)
+
+// TestParse tests the Parse function
+func TestParse(t *testing.T) {
+	expected := &Matrix{
+		rows:    2,
+		columns: 3,
+		values: [][]float64{
+			{1, 2, 3},
+			{4, 5, 6},
+		},
+	}
+
+	// Test case 1: Equivalent spellings of the same matrix
+	inputs := []string{
+		"[1 2 3; 4 5 6]",
+		"[1, 2, 3; 4, 5, 6]",
+		"1,2,3;4,5,6",
+		"[\n  1 2 3\n  4 5 6\n]",
+		"[1 2 3;\r\n 4 5 6;]",
+		"[1e0 0.2e1 +3; 8/2 10/2 6]",
+	}
+	for _, input := range inputs {
+		result, err := Parse(input)
+		if err != nil {
+			t.Errorf("Parse returned an error for %q: %v", input, err)
+			continue
+		}
+		if !matricesEqual(t, expected, result) {
+			t.Errorf("Parse failed for %q", input)
+		}
+	}
+
+	// Test case 2: Special values and fractions
+	result2, err := Parse("[Inf -Inf NaN 1/3 -2.5e-3]")
+	if err != nil {
+		t.Fatalf("Parse returned an error: %v", err)
+	}
+	v := result2.values[0]
+	if !math.IsInf(v[0], 1) || !math.IsInf(v[1], -1) || !math.IsNaN(v[2]) || v[3] != 1.0/3 || v[4] != -0.0025 {
+		t.Errorf("Parse failed for special values: got %v", v)
+	}
+
+	// Test case 3: An empty literal
+	result3, err := Parse("[]")
+	if err != nil || result3.rows != 0 || result3.columns != 0 {
+		t.Errorf("Parse failed for an empty literal: got %v, %v", result3, err)
+	}
+
+	// Test case 4: Invalid literals
+	invalid := []string{
+		"[1 2; 3]",
+		"[1 x]",
+		"[1 2",
+		"1 2]",
+		"[1,,2]",
+		"[1/]",
+	}
+	for _, input := range invalid {
+		if _, err := Parse(input); err == nil {
+			t.Errorf("Parse should return an error for %q", input)
+		}
+	}
+}
+
+// TestMustParse tests the MustParse function
+func TestMustParse(t *testing.T) {
+	// Test case 1: A valid literal works with existing operations
+	a := MustParse("[1 2; 3 4]")
+	b := MustParse("[1 0; 0 1]")
+	expected1 := MustParse("[2 2; 3 5]")
+
+	if !matricesEqual(t, &expected1, AddMatrices(a, b)) {
+		t.Errorf("MustParse failed to build matrices usable with AddMatrices")
+	}
+
+	// Test case 2: An invalid literal panics
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MustParse should panic for an invalid literal")
+		}
+	}()
+	MustParse("[1 2; 3]")
+}
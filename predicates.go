@@ -0,0 +1,221 @@
+package matrix
+
+import (
+	"math"
+)
+
+// The predicates in this file take an absolute tolerance tol: an element x is treated as zero if |x| <= tol,
+// and two elements x and y are treated as equal if |x - y| <= tol. A tolerance of zero makes the comparisons exact.
+
+// isZero reports whether x is zero within the absolute tolerance tol.
+func isZero(x, tol float64) bool {
+	return math.Abs(x) <= tol
+}
+
+// IsSquare reports whether the matrix has the same number of rows and columns.
+func IsSquare(m Matrix) bool {
+	return m.rows == m.columns
+}
+
+// IsSymmetric reports whether the matrix is square and equal to its transpose within tol.
+func IsSymmetric(m Matrix, tol float64) bool {
+	if !IsSquare(m) {
+		return false
+	}
+
+	for i := 0; i < m.rows; i++ {
+		for j := 0; j < i; j++ {
+			if !withinTolerance(m.values[i][j], m.values[j][i], tol, 0) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// IsSkewSymmetric reports whether the matrix is square and equal to the negative of its transpose within tol,
+// which also requires its diagonal to be zero.
+func IsSkewSymmetric(m Matrix, tol float64) bool {
+	if !IsSquare(m) {
+		return false
+	}
+
+	for i := 0; i < m.rows; i++ {
+		for j := 0; j <= i; j++ {
+			if !withinTolerance(m.values[i][j], -m.values[j][i], tol, 0) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// IsDiagonal reports whether the matrix is square and every element off the main diagonal is zero within tol.
+func IsDiagonal(m Matrix, tol float64) bool {
+	return IsSquare(m) && IsUpperTriangular(m, tol) && IsLowerTriangular(m, tol)
+}
+
+// IsUpperTriangular reports whether every element below the main diagonal is zero within tol.
+// Rectangular matrices are allowed.
+func IsUpperTriangular(m Matrix, tol float64) bool {
+	for i := 0; i < m.rows; i++ {
+		for j := 0; j < i && j < m.columns; j++ {
+			if !isZero(m.values[i][j], tol) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// IsLowerTriangular reports whether every element above the main diagonal is zero within tol.
+// Rectangular matrices are allowed.
+func IsLowerTriangular(m Matrix, tol float64) bool {
+	for i := 0; i < m.rows; i++ {
+		for j := i + 1; j < m.columns; j++ {
+			if !isZero(m.values[i][j], tol) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// IsIdentity reports whether the matrix is square with ones on the main diagonal and zeros elsewhere, within tol.
+func IsIdentity(m Matrix, tol float64) bool {
+	if !IsDiagonal(m, tol) {
+		return false
+	}
+
+	for i := 0; i < m.rows; i++ {
+		if !withinTolerance(m.values[i][i], 1, tol, 0) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsOrthogonal reports whether the matrix is square and its transpose times itself is the identity within tol,
+// that is, whether its columns are orthonormal.
+func IsOrthogonal(m Matrix, tol float64) bool {
+	if !IsSquare(m) {
+		return false
+	}
+
+	product := MultiplyMatrices(*TransposeMatrix(m), m)
+	return IsIdentity(*product, tol)
+}
+
+// IsPositiveDefinite reports whether the matrix is symmetric within tol and positive definite.
+// It attempts a Cholesky factorization of the lower triangle and requires every pivot to be greater than tol.
+func IsPositiveDefinite(m Matrix, tol float64) bool {
+	if !IsSymmetric(m, tol) {
+		return false
+	}
+
+	// Compute the Cholesky factor L row by row, with A = L·Lᵀ
+	n := m.rows
+	l := zeroMatrix(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j <= i; j++ {
+			sum := m.values[i][j]
+			for k := 0; k < j; k++ {
+				sum -= l.values[i][k] * l.values[j][k]
+			}
+
+			if i == j {
+				// The pivot must be positive; NaN fails this comparison too
+				if !(sum > tol) {
+					return false
+				}
+				l.values[i][i] = math.Sqrt(sum)
+			} else {
+				l.values[i][j] = sum / l.values[j][j]
+			}
+		}
+	}
+
+	return true
+}
+
+// IsDiagonallyDominant reports whether the matrix is square and, in every row, the absolute value of the diagonal
+// element is at least the sum of the absolute values of the other elements, less tol.
+func IsDiagonallyDominant(m Matrix, tol float64) bool {
+	if !IsSquare(m) {
+		return false
+	}
+
+	for i := 0; i < m.rows; i++ {
+		sum := 0.0
+		for j := 0; j < m.columns; j++ {
+			if j != i {
+				sum += math.Abs(m.values[i][j])
+			}
+		}
+		if !(math.Abs(m.values[i][i]) >= sum-tol) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// leadingColumn returns the column of the first element of the row that is not zero within tol, or -1 if there is none.
+func leadingColumn(row []float64, tol float64) int {
+	for j, v := range row {
+		if !isZero(v, tol) {
+			return j
+		}
+	}
+	return -1
+}
+
+// IsInRowEchelonForm reports whether the matrix is in row echelon form within tol: any zero rows are at the bottom,
+// and the leading element of each non-zero row is in a column to the right of the leading element of the row above.
+func IsInRowEchelonForm(m Matrix, tol float64) bool {
+	previous := -1
+	for i := 0; i < m.rows; i++ {
+		lead := leadingColumn(m.values[i], tol)
+		if lead == -1 {
+			// Every row after a zero row must also be zero
+			previous = m.columns
+			continue
+		}
+		if lead <= previous {
+			return false
+		}
+		previous = lead
+	}
+
+	return true
+}
+
+// IsInReducedRowEchelonForm reports whether the matrix is in reduced row echelon form within tol:
+// it is in row echelon form, every leading element is one, and every other element in a leading element's column is zero.
+func IsInReducedRowEchelonForm(m Matrix, tol float64) bool {
+	if !IsInRowEchelonForm(m, tol) {
+		return false
+	}
+
+	for i := 0; i < m.rows; i++ {
+		lead := leadingColumn(m.values[i], tol)
+		if lead == -1 {
+			break
+		}
+		if !withinTolerance(m.values[i][lead], 1, tol, 0) {
+			return false
+		}
+		for k := 0; k < m.rows; k++ {
+			if k != i && !isZero(m.values[k][lead], tol) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,148 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+)
+
+// TestShapePredicates tests IsSquare, IsSymmetric, IsSkewSymmetric, IsDiagonal, IsUpperTriangular, IsLowerTriangular and IsIdentity
+func TestShapePredicates(t *testing.T) {
+	symmetric := MustParse("[1 2 3; 2 4 5; 3 5 6]")
+	skew := MustParse("[0 2 -1; -2 0 4; 1 -4 0]")
+	upper := MustParse("[1 2 3; 0 4 5; 0 0 6]")
+	lower := *TransposeMatrix(upper)
+	diagonal := MustParse("[2 0 0; 0 -1 0; 0 0 3]")
+	identity := IdentityMatrix(3)
+	wide := MustParse("[1 2 3; 0 4 5]")
+
+	tests := []struct {
+		name     string
+		result   bool
+		expected bool
+	}{
+		{"IsSquare(symmetric)", IsSquare(symmetric), true},
+		{"IsSquare(wide)", IsSquare(wide), false},
+		{"IsSymmetric(symmetric)", IsSymmetric(symmetric, 0), true},
+		{"IsSymmetric(upper)", IsSymmetric(upper, 0), false},
+		{"IsSymmetric(wide)", IsSymmetric(wide, 10), false},
+		{"IsSkewSymmetric(skew)", IsSkewSymmetric(skew, 0), true},
+		{"IsSkewSymmetric(symmetric)", IsSkewSymmetric(symmetric, 0), false},
+		{"IsSkewSymmetric(identity)", IsSkewSymmetric(identity, 0), false},
+		{"IsDiagonal(diagonal)", IsDiagonal(diagonal, 0), true},
+		{"IsDiagonal(upper)", IsDiagonal(upper, 0), false},
+		{"IsUpperTriangular(upper)", IsUpperTriangular(upper, 0), true},
+		{"IsUpperTriangular(lower)", IsUpperTriangular(lower, 0), false},
+		{"IsUpperTriangular(wide)", IsUpperTriangular(wide, 0), true},
+		{"IsLowerTriangular(lower)", IsLowerTriangular(lower, 0), true},
+		{"IsLowerTriangular(upper)", IsLowerTriangular(upper, 0), false},
+		{"IsIdentity(identity)", IsIdentity(identity, 0), true},
+		{"IsIdentity(diagonal)", IsIdentity(diagonal, 0), false},
+	}
+	for _, test := range tests {
+		if test.result != test.expected {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, test.result)
+		}
+	}
+
+	// Small perturbations are accepted only within the tolerance
+	nearlySymmetric := MustParse("[1 2; 2.0000001 1]")
+	if !IsSymmetric(nearlySymmetric, 1e-6) || IsSymmetric(nearlySymmetric, 1e-9) {
+		t.Errorf("IsSymmetric should respect the tolerance")
+	}
+	nearlyIdentity := MustParse("[1.0000001 1e-8; 0 0.9999999]")
+	if !IsIdentity(nearlyIdentity, 1e-6) || IsIdentity(nearlyIdentity, 1e-9) {
+		t.Errorf("IsIdentity should respect the tolerance")
+	}
+}
+
+// TestIsOrthogonal tests the IsOrthogonal function
+func TestIsOrthogonal(t *testing.T) {
+	// Test case 1: A rotation is orthogonal up to rounding
+	theta := 0.3
+	rotation := NewMatrix(2, 2, [][]float64{
+		{math.Cos(theta), -math.Sin(theta)},
+		{math.Sin(theta), math.Cos(theta)},
+	})
+	if !IsOrthogonal(rotation, 1e-12) {
+		t.Errorf("IsOrthogonal should accept a rotation matrix")
+	}
+
+	// Test case 2: A permutation matrix is exactly orthogonal
+	if !IsOrthogonal(*PermuteRows(IdentityMatrix(3), []int{2, 0, 1}), 0) {
+		t.Errorf("IsOrthogonal should accept a permutation matrix")
+	}
+
+	// Test case 3: Scaling breaks orthogonality
+	if IsOrthogonal(*MultiplyRow(rotation, 0, 2), 1e-12) {
+		t.Errorf("IsOrthogonal should reject a scaled rotation matrix")
+	}
+}
+
+// TestIsPositiveDefinite tests the IsPositiveDefinite function
+func TestIsPositiveDefinite(t *testing.T) {
+	// Test case 1: The second difference matrix is positive definite
+	if !IsPositiveDefinite(MustParse("[2 -1 0; -1 2 -1; 0 -1 2]"), 1e-12) {
+		t.Errorf("IsPositiveDefinite should accept the second difference matrix")
+	}
+
+	// Test case 2: Indefinite and semidefinite matrices
+	if IsPositiveDefinite(MustParse("[1 2; 2 1]"), 1e-12) {
+		t.Errorf("IsPositiveDefinite should reject an indefinite matrix")
+	}
+	if IsPositiveDefinite(MustParse("[1 1; 1 1]"), 1e-12) {
+		t.Errorf("IsPositiveDefinite should reject a singular matrix")
+	}
+
+	// Test case 3: Non-symmetric matrices are rejected even if their symmetric part is positive definite
+	if IsPositiveDefinite(MustParse("[2 1; 0 2]"), 1e-12) {
+		t.Errorf("IsPositiveDefinite should reject a non-symmetric matrix")
+	}
+}
+
+// TestIsDiagonallyDominant tests the IsDiagonallyDominant function
+func TestIsDiagonallyDominant(t *testing.T) {
+	if !IsDiagonallyDominant(MustParse("[3 -1 1; 1 -4 2; 0 2 2]"), 0) {
+		t.Errorf("IsDiagonallyDominant should accept a weakly diagonally dominant matrix")
+	}
+	if IsDiagonallyDominant(MustParse("[1 2; 0 1]"), 0) {
+		t.Errorf("IsDiagonallyDominant should reject a matrix with a dominated row")
+	}
+	if !IsDiagonallyDominant(MustParse("[1 1.0000001; 0 1]"), 1e-6) {
+		t.Errorf("IsDiagonallyDominant should respect the tolerance")
+	}
+}
+
+// TestEchelonFormPredicates tests IsInRowEchelonForm and IsInReducedRowEchelonForm
+func TestEchelonFormPredicates(t *testing.T) {
+	// Test case 1: Hand-written forms
+	ref := MustParse("[2 1 3; 0 0 5; 0 0 0]")
+	rref := MustParse("[1 0 2; 0 1 -1; 0 0 0]")
+	if !IsInRowEchelonForm(ref, 0) || IsInReducedRowEchelonForm(ref, 0) {
+		t.Errorf("A row echelon form with non-unit pivots is not in reduced row echelon form")
+	}
+	if !IsInRowEchelonForm(rref, 0) || !IsInReducedRowEchelonForm(rref, 0) {
+		t.Errorf("IsInReducedRowEchelonForm should accept a reduced row echelon form")
+	}
+	if IsInRowEchelonForm(MustParse("[0 0; 1 0]"), 0) || IsInRowEchelonForm(MustParse("[1 2; 1 0]"), 0) {
+		t.Errorf("IsInRowEchelonForm should reject zero rows above non-zero rows and repeated pivot columns")
+	}
+	if IsInReducedRowEchelonForm(MustParse("[1 3; 0 1]"), 0) {
+		t.Errorf("IsInReducedRowEchelonForm should reject elements above a pivot")
+	}
+
+	// Test case 2: The output of the echelon functions satisfies the predicates
+	matrices := []Matrix{
+		MustParse("[2 1 -1 8; -3 -1 2 -11; -2 1 2 -3]"),
+		MustParse("[0 2 4; 1 1 1; 2 4 6]"),
+		MustParse("[1 2 3; 2 4 6; 1 1 1; 0 0 1]"),
+		randomTestMatrix(5, 7, 11),
+	}
+	for i, m := range matrices {
+		if !IsInRowEchelonForm(*RowEchelonForm(m), 1e-9) {
+			t.Errorf("RowEchelonForm output %d is not in row echelon form", i)
+		}
+		if !IsInReducedRowEchelonForm(*ReducedRowEchelonForm(m), 1e-9) {
+			t.Errorf("ReducedRowEchelonForm output %d is not in reduced row echelon form", i)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package matrix
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+// The generators in this file draw every random number from the given *rand.Rand, so a generator seeded
+// with the same value always produces the same matrix.
+
+// RandomUniform returns a pointer to a rows × cols matrix with elements drawn uniformly from [lo, hi).
+// Returns nil if either dimension is negative or lo is greater than hi.
+func RandomUniform(rng *rand.Rand, rows, cols int, lo, hi float64) *Matrix {
+	// Check if the dimensions and range are valid
+	if rows < 0 || cols < 0 || !(lo <= hi) {
+		return nil
+	}
+
+	result := zeroMatrix(rows, cols)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			result.values[i][j] = lo + (hi-lo)*rng.Float64()
+		}
+	}
+
+	return &result
+}
+
+// RandomNormal returns a pointer to a rows × cols matrix with elements drawn from the normal distribution
+// with the given mean and standard deviation.
+// Returns nil if either dimension is negative or stddev is negative.
+func RandomNormal(rng *rand.Rand, rows, cols int, mean, stddev float64) *Matrix {
+	// Check if the dimensions and standard deviation are valid
+	if rows < 0 || cols < 0 || !(stddev >= 0) {
+		return nil
+	}
+
+	result := zeroMatrix(rows, cols)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			result.values[i][j] = mean + stddev*rng.NormFloat64()
+		}
+	}
+
+	return &result
+}
+
+// RandomOrthogonal returns a pointer to an n × n orthogonal matrix drawn from the Haar distribution,
+// the uniform distribution over all orthogonal matrices. It orthonormalizes the columns of a matrix of
+// standard normal elements with Gram-Schmidt, which gives the Q of the QR factorization whose R has a
+// positive diagonal; that choice of signs is what makes the distribution uniform.
+// Returns nil if n is negative.
+func RandomOrthogonal(rng *rand.Rand, n int) *Matrix {
+	// Check if the size is valid
+	if n < 0 {
+		return nil
+	}
+
+	q := *RandomNormal(rng, n, n, 0, 1)
+	for j := 0; j < n; j++ {
+		// Remove the components along the previous columns, twice to keep the columns orthogonal to working precision
+		for pass := 0; pass < 2; pass++ {
+			for k := 0; k < j; k++ {
+				dot := 0.0
+				for i := 0; i < n; i++ {
+					dot += q.values[i][k] * q.values[i][j]
+				}
+				for i := 0; i < n; i++ {
+					q.values[i][j] -= dot * q.values[i][k]
+				}
+			}
+		}
+
+		// Normalize the column
+		norm := 0.0
+		for i := 0; i < n; i++ {
+			norm += q.values[i][j] * q.values[i][j]
+		}
+		norm = math.Sqrt(norm)
+		for i := 0; i < n; i++ {
+			q.values[i][j] /= norm
+		}
+	}
+
+	return &q
+}
+
+// RandomSPD returns a pointer to a random n × n symmetric positive definite matrix with 2-norm condition number cond.
+// It is Q·D·Qᵀ for a Haar-distributed orthogonal Q and a diagonal D whose elements are spaced geometrically
+// from 1 down to 1/cond, so the largest eigenvalue is 1 and the smallest is 1/cond.
+// Returns nil if n is negative, cond is less than one, or n is one and cond isn't one.
+func RandomSPD(rng *rand.Rand, n int, cond float64) *Matrix {
+	// Check if the size and condition number are valid
+	if n < 0 || !(cond >= 1) || math.IsInf(cond, 1) || (n == 1 && cond != 1) {
+		return nil
+	}
+
+	q := *RandomOrthogonal(rng, n)
+	eigenvalues := make([]float64, n)
+	for i := range eigenvalues {
+		eigenvalues[i] = 1
+		if n > 1 {
+			eigenvalues[i] = math.Pow(cond, -float64(i)/float64(n-1))
+		}
+	}
+
+	// Compute Q·D·Qᵀ, filling both triangles from the same sum so the result is exactly symmetric
+	result := zeroMatrix(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j <= i; j++ {
+			sum := 0.0
+			for k := 0; k < n; k++ {
+				sum += q.values[i][k] * eigenvalues[k] * q.values[j][k]
+			}
+			result.values[i][j] = sum
+			result.values[j][i] = sum
+		}
+	}
+
+	return &result
+}
+
+// RandomRank returns a pointer to a random rows × cols matrix of the given rank, the product of a rows × rank and
+// a rank × cols matrix of standard normal elements. Such factors have full rank with probability one.
+// Returns nil if either dimension is negative or rank is negative or greater than the smaller dimension.
+func RandomRank(rng *rand.Rand, rows, cols, rank int) *Matrix {
+	// Check if the dimensions and rank are valid
+	if rows < 0 || cols < 0 || rank < 0 || rank > min(rows, cols) {
+		return nil
+	}
+
+	left := RandomNormal(rng, rows, rank, 0, 1)
+	right := RandomNormal(rng, rank, cols, 0, 1)
+	return MultiplyMatrices(*left, *right)
+}
+
+// RandomUnimodular returns a pointer to a random n × n integer matrix with determinant ±1, whose inverse
+// therefore also has integer elements. It is P·L·U for a random permutation P, a unit lower triangular L and
+// a unit upper triangular U whose off-diagonal elements are integers drawn uniformly from [-bound, bound].
+// Larger bounds give larger elements in both the matrix and its inverse.
+// Returns nil if n or bound is negative.
+func RandomUnimodular(rng *rand.Rand, n, bound int) *Matrix {
+	// Check if the size and bound are valid
+	if n < 0 || bound < 0 {
+		return nil
+	}
+
+	l := IdentityMatrix(n)
+	u := IdentityMatrix(n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			l.values[i][j] = float64(rng.IntN(2*bound+1) - bound)
+			u.values[j][i] = float64(rng.IntN(2*bound+1) - bound)
+		}
+	}
+
+	return PermuteRows(*MultiplyMatrices(l, u), rng.Perm(n))
+}
@@ -0,0 +1,205 @@
+package matrix
+
+import (
+	"math"
+	"math/big"
+	"math/rand/v2"
+	"testing"
+)
+
+// newTestRand returns a random number generator with a fixed seed.
+func newTestRand(seed uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed, seed))
+}
+
+// TestRandomSeeding tests that the generators are reproducible from a seed
+func TestRandomSeeding(t *testing.T) {
+	generators := map[string]func(rng *rand.Rand) *Matrix{
+		"RandomUniform":    func(rng *rand.Rand) *Matrix { return RandomUniform(rng, 3, 4, -1, 1) },
+		"RandomNormal":     func(rng *rand.Rand) *Matrix { return RandomNormal(rng, 3, 4, 0, 1) },
+		"RandomOrthogonal": func(rng *rand.Rand) *Matrix { return RandomOrthogonal(rng, 4) },
+		"RandomSPD":        func(rng *rand.Rand) *Matrix { return RandomSPD(rng, 4, 10) },
+		"RandomRank":       func(rng *rand.Rand) *Matrix { return RandomRank(rng, 3, 4, 2) },
+		"RandomUnimodular": func(rng *rand.Rand) *Matrix { return RandomUnimodular(rng, 4, 2) },
+	}
+	for name, generate := range generators {
+		first := generate(newTestRand(42))
+		if !matricesEqual(t, first, generate(newTestRand(42))) {
+			t.Errorf("%s should produce the same matrix from the same seed", name)
+		}
+		if Equal(*first, *generate(newTestRand(43))) {
+			t.Errorf("%s should produce different matrices from different seeds", name)
+		}
+	}
+}
+
+// TestRandomUniformAndNormal tests the RandomUniform and RandomNormal functions
+func TestRandomUniformAndNormal(t *testing.T) {
+	rng := newTestRand(1)
+
+	// Test case 1: Uniform elements lie in the range
+	u := RandomUniform(rng, 20, 30, 2, 5)
+	for i := 0; i < u.rows; i++ {
+		for j := 0; j < u.columns; j++ {
+			if v := u.values[i][j]; v < 2 || v >= 5 {
+				t.Fatalf("RandomUniform produced %v outside [2, 5)", v)
+			}
+		}
+	}
+
+	// Test case 2: Normal elements have roughly the requested mean and standard deviation
+	n := RandomNormal(rng, 100, 100, 3, 2)
+	sum, sumSquares := 0.0, 0.0
+	for _, v := range Flatten(*n, RowMajor) {
+		sum += v
+		sumSquares += v * v
+	}
+	mean := sum / 10000
+	stddev := math.Sqrt(sumSquares/10000 - mean*mean)
+	if math.Abs(mean-3) > 0.1 || math.Abs(stddev-2) > 0.1 {
+		t.Errorf("RandomNormal produced mean %v and standard deviation %v, expected 3 and 2", mean, stddev)
+	}
+
+	// Test case 3: Invalid arguments
+	if RandomUniform(rng, -1, 2, 0, 1) != nil || RandomUniform(rng, 2, 2, 1, 0) != nil || RandomNormal(rng, 2, 2, 0, -1) != nil {
+		t.Errorf("RandomUniform and RandomNormal should return nil for invalid arguments")
+	}
+}
+
+// TestRandomOrthogonal tests the RandomOrthogonal function
+func TestRandomOrthogonal(t *testing.T) {
+	rng := newTestRand(2)
+	for _, n := range []int{1, 2, 10, 40} {
+		if q := RandomOrthogonal(rng, n); !IsOrthogonal(*q, 1e-12) {
+			t.Errorf("RandomOrthogonal produced a non-orthogonal %dx%d matrix", n, n)
+		}
+	}
+
+	// Haar-distributed matrices have determinant +1 and -1 equally often
+	positive := 0
+	for i := 0; i < 200; i++ {
+		det := RatDeterminant(*RatMatrixFromMatrix(*RandomOrthogonal(rng, 3)))
+		if det.Sign() > 0 {
+			positive++
+		}
+	}
+	if positive < 70 || positive > 130 {
+		t.Errorf("RandomOrthogonal produced %d of 200 matrices with positive determinant, expected about 100", positive)
+	}
+}
+
+// TestRandomSPD tests the RandomSPD function
+func TestRandomSPD(t *testing.T) {
+	rng := newTestRand(3)
+	a := *RandomSPD(rng, 5, 100)
+	if !IsSymmetric(a, 0) || !IsPositiveDefinite(a, 0) {
+		t.Fatalf("RandomSPD produced a matrix that is not symmetric positive definite")
+	}
+
+	// Estimate the largest eigenvalue of A and of its inverse by power iteration
+	largestEigenvalue := func(m Matrix) float64 {
+		v := zeroMatrix(m.rows, 1)
+		for i := range v.values {
+			v.values[i][0] = 1
+		}
+		lambda := 0.0
+		for iteration := 0; iteration < 200; iteration++ {
+			w := *MultiplyMatrices(m, v)
+			lambda = math.Sqrt(MultiplyMatrices(*TransposeMatrix(w), w).values[0][0])
+			v = *MultiplyColumn(w, 0, 1/lambda)
+		}
+		return lambda
+	}
+	n := a.rows
+	inverse := *Slice(*ReducedRowEchelonForm(*HStack(a, IdentityMatrix(n))), 0, n, n, 2*n)
+	largest := largestEigenvalue(a)
+	smallest := 1 / largestEigenvalue(inverse)
+	if math.Abs(largest-1) > 1e-9 || math.Abs(largest/smallest-100) > 1e-6 {
+		t.Errorf("RandomSPD produced eigenvalues %v and %v, expected 1 and 0.01", largest, smallest)
+	}
+
+	if RandomSPD(rng, 3, 0.5) != nil || RandomSPD(rng, 1, 2) != nil {
+		t.Errorf("RandomSPD should return nil for an unattainable condition number")
+	}
+}
+
+// numericalRank returns the rank of the matrix by Gaussian elimination with partial pivoting,
+// treating pivots smaller than tol as zero. RowEchelonForm can't be used for this, because it takes
+// any non-zero element as a pivot, including the rounding residue of a dependent row.
+func numericalRank(m Matrix, tol float64) int {
+	a := *Clone(m)
+	rank := 0
+	for col := 0; col < a.columns && rank < a.rows; col++ {
+		best := rank
+		for i := rank + 1; i < a.rows; i++ {
+			if math.Abs(a.values[i][col]) > math.Abs(a.values[best][col]) {
+				best = i
+			}
+		}
+		if math.Abs(a.values[best][col]) <= tol {
+			continue
+		}
+		a.values[rank], a.values[best] = a.values[best], a.values[rank]
+		for i := rank + 1; i < a.rows; i++ {
+			factor := a.values[i][col] / a.values[rank][col]
+			for j := col; j < a.columns; j++ {
+				a.values[i][j] -= factor * a.values[rank][j]
+			}
+		}
+		rank++
+	}
+	return rank
+}
+
+// TestRandomRank tests the RandomRank function
+func TestRandomRank(t *testing.T) {
+	rng := newTestRand(4)
+	for _, shape := range [][3]int{{5, 5, 5}, {5, 5, 2}, {4, 7, 3}, {7, 4, 1}, {3, 3, 0}} {
+		m := RandomRank(rng, shape[0], shape[1], shape[2])
+		if m.rows != shape[0] || m.columns != shape[1] {
+			t.Fatalf("RandomRank produced a %dx%d matrix, expected %dx%d", m.rows, m.columns, shape[0], shape[1])
+		}
+		if rank := numericalRank(*m, 1e-9); rank != shape[2] {
+			t.Errorf("RandomRank produced a %dx%d matrix of rank %d, expected %d", shape[0], shape[1], rank, shape[2])
+		}
+
+		// Full rank matrices are a randomized check of RowEchelonForm
+		if shape[2] == min(shape[0], shape[1]) && !IsInRowEchelonForm(*RowEchelonForm(*m), 1e-9) {
+			t.Errorf("RowEchelonForm of a random %dx%d matrix is not in row echelon form", shape[0], shape[1])
+		}
+	}
+
+	if RandomRank(rng, 2, 3, 3) != nil || RandomRank(rng, 2, 3, -1) != nil {
+		t.Errorf("RandomRank should return nil for a rank larger than the smaller dimension")
+	}
+}
+
+// TestRandomUnimodular tests the RandomUnimodular function
+func TestRandomUnimodular(t *testing.T) {
+	rng := newTestRand(5)
+	for i := 0; i < 20; i++ {
+		m := RandomUnimodular(rng, 4, 3)
+		exact := RatMatrixFromMatrix(*m)
+		if det := RatDeterminant(*exact); det.Cmp(big.NewRat(1, 1)) != 0 && det.Cmp(big.NewRat(-1, 1)) != 0 {
+			t.Fatalf("RandomUnimodular produced a matrix with determinant %v", det)
+		}
+
+		inverse := RatInverse(*exact)
+		for r := 0; r < 4; r++ {
+			for c := 0; c < 4; c++ {
+				if !exact.At(r, c).IsInt() || !inverse.At(r, c).IsInt() {
+					t.Fatalf("RandomUnimodular produced a matrix or inverse with non-integer elements")
+				}
+			}
+		}
+	}
+
+	// A bound of zero gives a permutation matrix
+	if p := RandomUnimodular(rng, 5, 0); !IsOrthogonal(*p, 0) {
+		t.Errorf("RandomUnimodular with bound 0 should produce a permutation matrix")
+	}
+
+	if RandomUnimodular(rng, -1, 1) != nil || RandomUnimodular(rng, 2, -1) != nil {
+		t.Errorf("RandomUnimodular should return nil for a negative size or bound")
+	}
+}
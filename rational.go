@@ -0,0 +1,344 @@
+package matrix
+
+import (
+	"math"
+	"math/big"
+)
+
+// RatMatrix is a matrix of exact rational numbers. Every operation on it is exact,
+// so elimination produces results such as 1/3 instead of 0.33333333 and 0 instead of -1.1e-16.
+type RatMatrix struct {
+	rows    int
+	columns int
+	values  [][]*big.Rat
+}
+
+// NewRatMatrix creates a rows × cols rational matrix with a copy of the given values.
+func NewRatMatrix(rows, cols int, values [][]*big.Rat) RatMatrix {
+	m := newRatMatrix(rows, cols)
+
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			m.values[i][j].Set(values[i][j])
+		}
+	}
+
+	return m
+}
+
+// newRatMatrix creates a rows × cols rational matrix of zeros.
+func newRatMatrix(rows, cols int) RatMatrix {
+	m := RatMatrix{
+		rows:    rows,
+		columns: cols,
+		values:  make([][]*big.Rat, rows),
+	}
+
+	for i := 0; i < rows; i++ {
+		m.values[i] = make([]*big.Rat, cols)
+		for j := 0; j < cols; j++ {
+			m.values[i][j] = new(big.Rat)
+		}
+	}
+
+	return m
+}
+
+// cloneRat returns a pointer to a deep copy of the rational matrix.
+func cloneRat(m RatMatrix) *RatMatrix {
+	result := NewRatMatrix(m.rows, m.columns, m.values)
+	return &result
+}
+
+// String returns the matrix rendered with aligned columns of exact fractions.
+func (m RatMatrix) String() string {
+	return renderMatrix(m.rows, m.columns, func(i, j int) string {
+		return m.values[i][j].RatString()
+	}, 0, true)
+}
+
+// At returns a copy of the element at row i and column j.
+func (m RatMatrix) At(i, j int) *big.Rat {
+	return new(big.Rat).Set(m.values[i][j])
+}
+
+// RatMatrixFromMatrix converts a matrix to a rational matrix and returns a pointer to the result.
+// The conversion is exact, so a value such as 0.1 becomes the rational closest to it in binary, not 1/10.
+// Returns nil if the matrix contains NaN or an infinity.
+func RatMatrixFromMatrix(m Matrix) *RatMatrix {
+	result := newRatMatrix(m.rows, m.columns)
+
+	for i := 0; i < m.rows; i++ {
+		for j := 0; j < m.columns; j++ {
+			v := m.values[i][j]
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				return nil
+			}
+			result.values[i][j].SetFloat64(v)
+		}
+	}
+
+	return &result
+}
+
+// MatrixFromRatMatrix converts a rational matrix to a matrix, rounding every element to the nearest float64.
+func MatrixFromRatMatrix(m RatMatrix) Matrix {
+	result := Matrix{
+		rows:    m.rows,
+		columns: m.columns,
+		values:  make([][]float64, m.rows),
+	}
+
+	for i := 0; i < m.rows; i++ {
+		result.values[i] = make([]float64, m.columns)
+		for j := 0; j < m.columns; j++ {
+			result.values[i][j], _ = m.values[i][j].Float64()
+		}
+	}
+
+	return result
+}
+
+// AddRatMatrices adds two rational matrices and returns a pointer to the resulting matrix.
+// Returns nil if the matrices have different dimensions.
+func AddRatMatrices(a, b RatMatrix) *RatMatrix {
+	// Check if matrices have the same dimensions
+	if a.rows != b.rows || a.columns != b.columns {
+		return nil
+	}
+
+	result := newRatMatrix(a.rows, a.columns)
+	for i := 0; i < a.rows; i++ {
+		for j := 0; j < a.columns; j++ {
+			result.values[i][j].Add(a.values[i][j], b.values[i][j])
+		}
+	}
+
+	return &result
+}
+
+// SubtractRatMatrices subtracts the second rational matrix from the first and returns a pointer to the resulting matrix.
+// Returns nil if the matrices have different dimensions.
+func SubtractRatMatrices(a, b RatMatrix) *RatMatrix {
+	// Check if matrices have the same dimensions
+	if a.rows != b.rows || a.columns != b.columns {
+		return nil
+	}
+
+	result := newRatMatrix(a.rows, a.columns)
+	for i := 0; i < a.rows; i++ {
+		for j := 0; j < a.columns; j++ {
+			result.values[i][j].Sub(a.values[i][j], b.values[i][j])
+		}
+	}
+
+	return &result
+}
+
+// MultiplyRatMatrices multiplies two rational matrices and returns a pointer to the resulting matrix.
+// Returns nil if the number of columns in the first matrix does not equal the number of rows in the second matrix.
+func MultiplyRatMatrices(a, b RatMatrix) *RatMatrix {
+	// Check if matrices can be multiplied
+	if a.columns != b.rows {
+		return nil
+	}
+
+	result := newRatMatrix(a.rows, b.columns)
+	product := new(big.Rat)
+	for i := 0; i < a.rows; i++ {
+		for j := 0; j < b.columns; j++ {
+			// Calculate the dot product of row i from matrix a and column j from matrix b
+			for k := 0; k < a.columns; k++ {
+				product.Mul(a.values[i][k], b.values[k][j])
+				result.values[i][j].Add(result.values[i][j], product)
+			}
+		}
+	}
+
+	return &result
+}
+
+// TransposeRatMatrix transposes a rational matrix and returns a pointer to the resulting matrix.
+func TransposeRatMatrix(m RatMatrix) *RatMatrix {
+	result := newRatMatrix(m.columns, m.rows)
+	for i := 0; i < m.columns; i++ {
+		for j := 0; j < m.rows; j++ {
+			result.values[i][j].Set(m.values[j][i])
+		}
+	}
+
+	return &result
+}
+
+// SwapRatRows swaps two rows in the rational matrix and returns a pointer to the resulting matrix.
+// Returns nil if either row index is out of bounds.
+func SwapRatRows(m RatMatrix, row1, row2 int) *RatMatrix {
+	// Check if row indices are valid
+	if row1 < 0 || row1 >= m.rows || row2 < 0 || row2 >= m.rows {
+		return nil
+	}
+
+	result := cloneRat(m)
+	result.values[row1], result.values[row2] = result.values[row2], result.values[row1]
+	return result
+}
+
+// MultiplyRatRow multiplies a row in the rational matrix by a scalar and returns a pointer to the resulting matrix.
+// Returns nil if the row index is out of bounds.
+func MultiplyRatRow(m RatMatrix, row int, scalar *big.Rat) *RatMatrix {
+	// Check if row index is valid
+	if row < 0 || row >= m.rows {
+		return nil
+	}
+
+	result := cloneRat(m)
+	for j := 0; j < m.columns; j++ {
+		result.values[row][j].Mul(result.values[row][j], scalar)
+	}
+	return result
+}
+
+// AddScaledRatRow adds a source row multiplied by a scalar to a target row in the rational matrix
+// and returns a pointer to the resulting matrix.
+// Returns nil if either row index is out of bounds.
+func AddScaledRatRow(m RatMatrix, targetRow, sourceRow int, scalar *big.Rat) *RatMatrix {
+	// Check if row indices are valid
+	if targetRow < 0 || targetRow >= m.rows || sourceRow < 0 || sourceRow >= m.rows {
+		return nil
+	}
+
+	result := cloneRat(m)
+	product := new(big.Rat)
+	for j := 0; j < m.columns; j++ {
+		product.Mul(m.values[sourceRow][j], scalar)
+		result.values[targetRow][j].Add(result.values[targetRow][j], product)
+	}
+	return result
+}
+
+// ratEliminate performs exact Gaussian elimination on a copy of the rational matrix, or Gauss-Jordan elimination
+// if reduced is true, choosing pivots the same way as RowEchelonForm. It returns the resulting matrix
+// and the determinant of the row operations applied, i.e. det(result) / det(m) for square matrices.
+func ratEliminate(m RatMatrix, reduced bool) (*RatMatrix, *big.Rat) {
+	result := cloneRat(m)
+	scale := big.NewRat(1, 1)
+	product := new(big.Rat)
+
+	// Current row being processed
+	row := 0
+
+	// Process each column
+	for col := 0; col < m.columns && row < m.rows; col++ {
+		// Find the pivot row (first row with non-zero element in current column)
+		pivotRow := -1
+		for i := row; i < m.rows; i++ {
+			if result.values[i][col].Sign() != 0 {
+				pivotRow = i
+				break
+			}
+		}
+
+		// If no pivot found in this column, move to the next column
+		if pivotRow == -1 {
+			continue
+		}
+
+		// Swap the pivot row with the current row if they're different
+		if pivotRow != row {
+			result.values[row], result.values[pivotRow] = result.values[pivotRow], result.values[row]
+			scale.Neg(scale)
+		}
+
+		// Scale the pivot row to make the pivot element 1
+		inverse := new(big.Rat).Inv(result.values[row][col])
+		for j := col; j < m.columns; j++ {
+			result.values[row][j].Mul(result.values[row][j], inverse)
+		}
+		scale.Mul(scale, inverse)
+
+		// Eliminate the other elements in the current column
+		start := row + 1
+		if reduced {
+			start = 0
+		}
+		for i := start; i < m.rows; i++ {
+			if i == row || result.values[i][col].Sign() == 0 {
+				continue
+			}
+			factor := new(big.Rat).Neg(result.values[i][col])
+			for j := col; j < m.columns; j++ {
+				product.Mul(result.values[row][j], factor)
+				result.values[i][j].Add(result.values[i][j], product)
+			}
+		}
+
+		// Move to the next row
+		row++
+	}
+
+	return result, scale
+}
+
+// RatRowEchelonForm performs exact Gaussian elimination on a rational matrix
+// and returns a pointer to the resulting matrix in row echelon form.
+func RatRowEchelonForm(m RatMatrix) *RatMatrix {
+	result, _ := ratEliminate(m, false)
+	return result
+}
+
+// RatReducedRowEchelonForm performs exact Gauss-Jordan elimination on a rational matrix
+// and returns a pointer to the resulting matrix in reduced row echelon form.
+func RatReducedRowEchelonForm(m RatMatrix) *RatMatrix {
+	result, _ := ratEliminate(m, true)
+	return result
+}
+
+// RatDeterminant returns the exact determinant of a square rational matrix.
+// Returns nil if the matrix is not square.
+func RatDeterminant(m RatMatrix) *big.Rat {
+	if m.rows != m.columns {
+		return nil
+	}
+
+	// The row echelon form has a unit diagonal unless the matrix is singular,
+	// so the determinant is the inverse of the determinant of the row operations
+	result, scale := ratEliminate(m, false)
+	for i := 0; i < m.rows; i++ {
+		if result.values[i][i].Sign() == 0 {
+			return new(big.Rat)
+		}
+	}
+	return scale.Inv(scale)
+}
+
+// RatInverse computes the exact inverse of a square rational matrix and returns a pointer to it.
+// Returns nil if the matrix is not square or is singular.
+func RatInverse(m RatMatrix) *RatMatrix {
+	if m.rows != m.columns {
+		return nil
+	}
+	n := m.rows
+
+	// Reduce the augmented matrix [m | I] to [I | m⁻¹]
+	augmented := newRatMatrix(n, 2*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			augmented.values[i][j].Set(m.values[i][j])
+		}
+		augmented.values[i][n+i].SetInt64(1)
+	}
+	reduced, _ := ratEliminate(augmented, true)
+
+	// The matrix is singular if the left half did not reduce to the identity
+	result := newRatMatrix(n, n)
+	for i := 0; i < n; i++ {
+		if reduced.values[i][i].Cmp(big.NewRat(1, 1)) != 0 {
+			return nil
+		}
+		for j := 0; j < n; j++ {
+			result.values[i][j].Set(reduced.values[i][n+j])
+		}
+	}
+
+	return &result
+}
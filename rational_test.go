@@ -0,0 +1,243 @@
+package matrix
+
+import (
+	"math/big"
+	"testing"
+)
+
+// ratMatrix builds a rational matrix from numerator/denominator strings such as "1/3".
+func ratMatrix(t *testing.T, rows [][]string) RatMatrix {
+	t.Helper()
+
+	values := make([][]*big.Rat, len(rows))
+	for i, row := range rows {
+		values[i] = make([]*big.Rat, len(row))
+		for j, s := range row {
+			v, ok := new(big.Rat).SetString(s)
+			if !ok {
+				t.Fatalf("invalid rational %q", s)
+			}
+			values[i][j] = v
+		}
+	}
+
+	cols := 0
+	if len(rows) > 0 {
+		cols = len(rows[0])
+	}
+	return NewRatMatrix(len(rows), cols, values)
+}
+
+// ratMatricesEqual compares two rational matrices exactly.
+func ratMatricesEqual(t *testing.T, expected, actual *RatMatrix) bool {
+	if expected == nil && actual == nil {
+		return true
+	}
+
+	if expected == nil || actual == nil {
+		t.Errorf("One matrix is nil and the other is not")
+		return false
+	}
+
+	if expected.rows != actual.rows || expected.columns != actual.columns {
+		t.Errorf("Matrix dimensions don't match: expected %dx%d, got %dx%d",
+			expected.rows, expected.columns, actual.rows, actual.columns)
+		return false
+	}
+
+	for i := 0; i < expected.rows; i++ {
+		for j := 0; j < expected.columns; j++ {
+			if expected.values[i][j].Cmp(actual.values[i][j]) != 0 {
+				t.Errorf("Matrix values don't match at position [%d][%d]: expected %s, got %s",
+					i, j, expected.values[i][j].RatString(), actual.values[i][j].RatString())
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// TestRatArithmetic tests AddRatMatrices, SubtractRatMatrices, MultiplyRatMatrices and TransposeRatMatrix
+func TestRatArithmetic(t *testing.T) {
+	a := ratMatrix(t, [][]string{
+		{"1/2", "1/3"},
+		{"1", "-2/5"},
+	})
+	b := ratMatrix(t, [][]string{
+		{"1/2", "2/3"},
+		{"0", "2/5"},
+	})
+
+	// Test case 1: Addition and subtraction
+	sum := ratMatrix(t, [][]string{{"1", "1"}, {"1", "0"}})
+	if !ratMatricesEqual(t, &sum, AddRatMatrices(a, b)) {
+		t.Errorf("AddRatMatrices failed")
+	}
+	difference := ratMatrix(t, [][]string{{"0", "-1/3"}, {"1", "-4/5"}})
+	if !ratMatricesEqual(t, &difference, SubtractRatMatrices(a, b)) {
+		t.Errorf("SubtractRatMatrices failed")
+	}
+
+	// Test case 2: Multiplication
+	product := ratMatrix(t, [][]string{{"1/4", "7/15"}, {"1/2", "76/150"}})
+	if !ratMatricesEqual(t, &product, MultiplyRatMatrices(a, b)) {
+		t.Errorf("MultiplyRatMatrices failed")
+	}
+
+	// Test case 3: Transposition
+	transposed := ratMatrix(t, [][]string{{"1/2", "1"}, {"1/3", "-2/5"}})
+	if !ratMatricesEqual(t, &transposed, TransposeRatMatrix(a)) {
+		t.Errorf("TransposeRatMatrix failed")
+	}
+
+	// Test case 4: Mismatched dimensions
+	c := ratMatrix(t, [][]string{{"1", "2", "3"}})
+	if AddRatMatrices(a, c) != nil || MultiplyRatMatrices(c, a) != nil {
+		t.Errorf("Rational arithmetic should return nil for mismatched dimensions")
+	}
+}
+
+// TestRatRowOperations tests SwapRatRows, MultiplyRatRow and AddScaledRatRow
+func TestRatRowOperations(t *testing.T) {
+	m := ratMatrix(t, [][]string{
+		{"1", "2"},
+		{"3", "4"},
+	})
+
+	swapped := ratMatrix(t, [][]string{{"3", "4"}, {"1", "2"}})
+	if !ratMatricesEqual(t, &swapped, SwapRatRows(m, 0, 1)) {
+		t.Errorf("SwapRatRows failed")
+	}
+
+	scaled := ratMatrix(t, [][]string{{"1/3", "2/3"}, {"3", "4"}})
+	if !ratMatricesEqual(t, &scaled, MultiplyRatRow(m, 0, big.NewRat(1, 3))) {
+		t.Errorf("MultiplyRatRow failed")
+	}
+
+	added := ratMatrix(t, [][]string{{"1", "2"}, {"0", "-2"}})
+	if !ratMatricesEqual(t, &added, AddScaledRatRow(m, 1, 0, big.NewRat(-3, 1))) {
+		t.Errorf("AddScaledRatRow failed")
+	}
+
+	// The original matrix is not modified
+	original := ratMatrix(t, [][]string{{"1", "2"}, {"3", "4"}})
+	if !ratMatricesEqual(t, &original, &m) {
+		t.Errorf("Rational row operations should not modify their input")
+	}
+
+	if SwapRatRows(m, 0, 2) != nil || MultiplyRatRow(m, -1, big.NewRat(1, 1)) != nil {
+		t.Errorf("Rational row operations should return nil for out of bounds rows")
+	}
+}
+
+// TestRatEchelonForms tests RatRowEchelonForm and RatReducedRowEchelonForm
+func TestRatEchelonForms(t *testing.T) {
+	m := ratMatrix(t, [][]string{
+		{"3", "1", "1"},
+		{"1", "2", "0"},
+	})
+
+	// Test case 1: Row echelon form has exact fractions
+	ref := ratMatrix(t, [][]string{
+		{"1", "1/3", "1/3"},
+		{"0", "1", "-1/5"},
+	})
+	if !ratMatricesEqual(t, &ref, RatRowEchelonForm(m)) {
+		t.Errorf("RatRowEchelonForm failed")
+	}
+
+	// Test case 2: Reduced row echelon form has exact zeros
+	rref := ratMatrix(t, [][]string{
+		{"1", "0", "2/5"},
+		{"0", "1", "-1/5"},
+	})
+	if !ratMatricesEqual(t, &rref, RatReducedRowEchelonForm(m)) {
+		t.Errorf("RatReducedRowEchelonForm failed")
+	}
+}
+
+// TestRatDeterminant tests the RatDeterminant function
+func TestRatDeterminant(t *testing.T) {
+	cases := []struct {
+		m        [][]string
+		expected string
+	}{
+		{[][]string{{"1/2", "1/3"}, {"1/4", "1/5"}}, "1/60"},
+		{[][]string{{"0", "1"}, {"1", "0"}}, "-1"},
+		{[][]string{{"1", "2"}, {"2", "4"}}, "0"},
+		{[][]string{{"2", "0", "1"}, {"1", "3", "2"}, {"1", "1", "2"}}, "6"},
+	}
+
+	for _, c := range cases {
+		result := RatDeterminant(ratMatrix(t, c.m))
+		expected, _ := new(big.Rat).SetString(c.expected)
+		if result == nil || result.Cmp(expected) != 0 {
+			t.Errorf("RatDeterminant(%v) = %v, expected %s", c.m, result, c.expected)
+		}
+	}
+
+	if RatDeterminant(ratMatrix(t, [][]string{{"1", "2"}})) != nil {
+		t.Errorf("RatDeterminant should return nil for a non-square matrix")
+	}
+}
+
+// TestRatInverse tests the RatInverse function
+func TestRatInverse(t *testing.T) {
+	// Test case 1: The inverse of a Hilbert matrix is exact
+	m := ratMatrix(t, [][]string{
+		{"1", "1/2", "1/3"},
+		{"1/2", "1/3", "1/4"},
+		{"1/3", "1/4", "1/5"},
+	})
+	expected := ratMatrix(t, [][]string{
+		{"9", "-36", "30"},
+		{"-36", "192", "-180"},
+		{"30", "-180", "180"},
+	})
+	inverse := RatInverse(m)
+	if !ratMatricesEqual(t, &expected, inverse) {
+		t.Errorf("RatInverse failed for the 3x3 Hilbert matrix")
+	}
+
+	identity := ratMatrix(t, [][]string{{"1", "0", "0"}, {"0", "1", "0"}, {"0", "0", "1"}})
+	if !ratMatricesEqual(t, &identity, MultiplyRatMatrices(m, *inverse)) {
+		t.Errorf("A matrix times its inverse should be exactly the identity")
+	}
+
+	// Test case 2: Singular and non-square matrices
+	if RatInverse(ratMatrix(t, [][]string{{"1", "2"}, {"1/2", "1"}})) != nil {
+		t.Errorf("RatInverse should return nil for a singular matrix")
+	}
+	if RatInverse(ratMatrix(t, [][]string{{"1", "2"}})) != nil {
+		t.Errorf("RatInverse should return nil for a non-square matrix")
+	}
+}
+
+// TestRatMatrixConversion tests RatMatrixFromMatrix and MatrixFromRatMatrix
+func TestRatMatrixConversion(t *testing.T) {
+	// Test case 1: Round trip through the rational type is exact
+	m := MustParse("[0.5 -3; 0.1 1e10]")
+	r := RatMatrixFromMatrix(m)
+	if r == nil {
+		t.Fatalf("RatMatrixFromMatrix returned nil for finite values")
+	}
+	if r.At(0, 0).Cmp(big.NewRat(1, 2)) != 0 {
+		t.Errorf("RatMatrixFromMatrix failed: got %s for 0.5", r.At(0, 0).RatString())
+	}
+	back := MatrixFromRatMatrix(*r)
+	if !matricesEqual(t, &m, &back) {
+		t.Errorf("MatrixFromRatMatrix did not reproduce the original matrix")
+	}
+
+	// Test case 2: Non-finite values cannot be converted
+	if RatMatrixFromMatrix(MustParse("[1 NaN]")) != nil {
+		t.Errorf("RatMatrixFromMatrix should return nil for NaN")
+	}
+
+	// Test case 3: String shows exact fractions
+	s := ratMatrix(t, [][]string{{"1/3", "-2"}, {"10", "0"}})
+	if expected := "⎡1/3  -2⎤\n⎣ 10   0⎦"; s.String() != expected {
+		t.Errorf("RatMatrix.String failed: expected\n%s\ngot\n%s", expected, s.String())
+	}
+}
@@ -0,0 +1,202 @@
+package matrix
+
+import (
+	"fmt"
+	"html"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// RenderOptions configures RenderLaTeX, RenderMarkdown and RenderHTML.
+// The zero value renders every value with its shortest exact representation in a bracketed LaTeX matrix.
+type RenderOptions struct {
+	// Format and Precision are passed to strconv.FormatFloat.
+	// A zero Format uses the shortest representation that reads back exactly.
+	Format    byte
+	Precision int
+
+	// Fractions renders values that are close to a fraction with a small denominator as that fraction.
+	Fractions bool
+	// MaxDenominator is the largest denominator used when Fractions is set. It defaults to 100.
+	MaxDenominator int
+
+	// Environment is the LaTeX matrix environment, "bmatrix" (the default) or "pmatrix".
+	Environment string
+	// Augment, if between 1 and the number of columns minus 1, draws a vertical bar after that many columns
+	// in LaTeX and HTML output, as in an augmented matrix [A | b].
+	Augment int
+}
+
+// fraction returns the numerator and denominator of the fraction with a denominator of at most maxDen
+// that is closest to v, using the continued fraction expansion of v.
+// ok is false if no such fraction is within a relative distance of 1e-9 of v.
+func fraction(v float64, maxDen int) (num, den int64, ok bool) {
+	if math.IsNaN(v) || math.IsInf(v, 0) || math.Abs(v) > 1<<53 {
+		return 0, 0, false
+	}
+
+	// Build successive convergents h/k until the denominator would exceed maxDen
+	h0, h1 := int64(0), int64(1)
+	k0, k1 := int64(1), int64(0)
+	x := math.Abs(v)
+	for {
+		a := math.Floor(x)
+		h2 := int64(a)*h1 + h0
+		k2 := int64(a)*k1 + k0
+		if k2 > int64(maxDen) {
+			break
+		}
+		h0, h1, k0, k1 = h1, h2, k1, k2
+		if x-a < 1e-12 {
+			break
+		}
+		x = 1 / (x - a)
+	}
+
+	if k1 == 0 || math.Abs(float64(h1)/float64(k1)-math.Abs(v)) > 1e-9*math.Max(1, math.Abs(v)) {
+		return 0, 0, false
+	}
+	if v < 0 {
+		h1 = -h1
+	}
+	return h1, k1, true
+}
+
+// renderValue formats a value according to the options. fractionLayout receives the numerator and
+// denominator of values rendered as fractions with a denominator other than 1.
+func renderValue(v float64, opts RenderOptions, fractionLayout func(num, den int64) string) string {
+	if opts.Fractions {
+		maxDen := opts.MaxDenominator
+		if maxDen <= 0 {
+			maxDen = 100
+		}
+		if num, den, ok := fraction(v, maxDen); ok {
+			if den == 1 {
+				return strconv.FormatInt(num, 10)
+			}
+			return fractionLayout(num, den)
+		}
+	}
+
+	format, precision := opts.Format, opts.Precision
+	if format == 0 {
+		format, precision = 'g', -1
+	}
+	return strconv.FormatFloat(v, format, precision, 64)
+}
+
+// plainFraction lays out a fraction as "num/den".
+func plainFraction(num, den int64) string {
+	return fmt.Sprintf("%d/%d", num, den)
+}
+
+// hasAugment reports whether the options draw an augmentation bar for the matrix.
+func hasAugment(m Matrix, opts RenderOptions) bool {
+	return opts.Augment > 0 && opts.Augment < m.columns
+}
+
+// RenderLaTeX renders the matrix as a LaTeX matrix environment, or as an array with a vertical bar
+// when an augmentation column is set.
+func RenderLaTeX(m Matrix, opts RenderOptions) string {
+	env := opts.Environment
+	if env == "" {
+		env = "bmatrix"
+	}
+
+	// Choose the opening and closing lines
+	begin, end := `\begin{`+env+`}`, `\end{`+env+`}`
+	if hasAugment(m, opts) {
+		left, right := `\left[`, `\right]`
+		if env == "pmatrix" {
+			left, right = `\left(`, `\right)`
+		}
+		spec := strings.Repeat("c", opts.Augment) + "|" + strings.Repeat("c", m.columns-opts.Augment)
+		begin, end = left+`\begin{array}{`+spec+`}`, `\end{array}`+right
+	}
+
+	latexFraction := func(num, den int64) string {
+		if num < 0 {
+			return fmt.Sprintf(`-\frac{%d}{%d}`, -num, den)
+		}
+		return fmt.Sprintf(`\frac{%d}{%d}`, num, den)
+	}
+
+	var b strings.Builder
+	b.WriteString(begin + "\n")
+	for i := 0; i < m.rows; i++ {
+		for j := 0; j < m.columns; j++ {
+			if j > 0 {
+				b.WriteString(" & ")
+			}
+			v := m.values[i][j]
+			switch {
+			case math.IsInf(v, 1):
+				b.WriteString(`\infty`)
+			case math.IsInf(v, -1):
+				b.WriteString(`-\infty`)
+			case math.IsNaN(v):
+				b.WriteString(`\mathrm{NaN}`)
+			default:
+				b.WriteString(renderValue(v, opts, latexFraction))
+			}
+		}
+		if i < m.rows-1 {
+			b.WriteString(` \\`)
+		}
+		b.WriteByte('\n')
+	}
+	b.WriteString(end)
+
+	return b.String()
+}
+
+// RenderMarkdown renders the matrix as a GitHub Markdown table with right-aligned columns
+// headed by their 1-based column numbers. Markdown has no vertical rules, so Augment is ignored.
+func RenderMarkdown(m Matrix, opts RenderOptions) string {
+	var b strings.Builder
+
+	// Write the header and alignment rows
+	b.WriteString("|")
+	for j := 0; j < m.columns; j++ {
+		fmt.Fprintf(&b, " %d |", j+1)
+	}
+	b.WriteString("\n|")
+	for j := 0; j < m.columns; j++ {
+		b.WriteString(" ---: |")
+	}
+
+	// Write each row of values
+	for i := 0; i < m.rows; i++ {
+		b.WriteString("\n|")
+		for j := 0; j < m.columns; j++ {
+			fmt.Fprintf(&b, " %s |", renderValue(m.values[i][j], opts, plainFraction))
+		}
+	}
+
+	return b.String()
+}
+
+// RenderHTML renders the matrix as an HTML table. When an augmentation column is set,
+// the first cell after it in every row gets a left border.
+func RenderHTML(m Matrix, opts RenderOptions) string {
+	var b strings.Builder
+
+	b.WriteString("<table class=\"matrix\">\n")
+	for i := 0; i < m.rows; i++ {
+		b.WriteString("<tr>")
+		for j := 0; j < m.columns; j++ {
+			if hasAugment(m, opts) && j == opts.Augment {
+				b.WriteString(`<td style="border-left: 1px solid">`)
+			} else {
+				b.WriteString("<td>")
+			}
+			b.WriteString(html.EscapeString(renderValue(m.values[i][j], opts, plainFraction)))
+			b.WriteString("</td>")
+		}
+		b.WriteString("</tr>\n")
+	}
+	b.WriteString("</table>")
+
+	return b.String()
+}
@@ -0,0 +1,84 @@
+package matrix
+
+import (
+	"testing"
+)
+
+// TestRenderLaTeX tests the RenderLaTeX function
+func TestRenderLaTeX(t *testing.T) {
+	m := MustParse("[1 0.5; -2 1/3]")
+
+	// Test case 1: Default bmatrix with decimal values
+	expected1 := "\\begin{bmatrix}\n1 & 0.5 \\\\\n-2 & 0.333\n\\end{bmatrix}"
+	if result1 := RenderLaTeX(m, RenderOptions{Format: 'g', Precision: 3}); result1 != expected1 {
+		t.Errorf("RenderLaTeX failed: expected\n%s\ngot\n%s", expected1, result1)
+	}
+
+	// Test case 2: pmatrix with fractions
+	expected2 := "\\begin{pmatrix}\n1 & \\frac{1}{2} \\\\\n-2 & \\frac{1}{3}\n\\end{pmatrix}"
+	if result2 := RenderLaTeX(m, RenderOptions{Environment: "pmatrix", Fractions: true}); result2 != expected2 {
+		t.Errorf("RenderLaTeX failed for fractions: expected\n%s\ngot\n%s", expected2, result2)
+	}
+
+	// Test case 3: Augmented matrix with a negative fraction
+	a := MustParse("[1 2 -1/4; 3 4 5]")
+	expected3 := "\\left[\\begin{array}{cc|c}\n1 & 2 & -\\frac{1}{4} \\\\\n3 & 4 & 5\n\\end{array}\\right]"
+	if result3 := RenderLaTeX(a, RenderOptions{Fractions: true, Augment: 2}); result3 != expected3 {
+		t.Errorf("RenderLaTeX failed for augmented matrix: expected\n%s\ngot\n%s", expected3, result3)
+	}
+}
+
+// TestRenderMarkdown tests the RenderMarkdown function
+func TestRenderMarkdown(t *testing.T) {
+	m := MustParse("[1 2.25; 3 2/3]")
+
+	// Test case 1: Fixed precision
+	expected1 := "| 1 | 2 |\n| ---: | ---: |\n| 1.00 | 2.25 |\n| 3.00 | 0.67 |"
+	if result1 := RenderMarkdown(m, RenderOptions{Format: 'f', Precision: 2}); result1 != expected1 {
+		t.Errorf("RenderMarkdown failed: expected\n%s\ngot\n%s", expected1, result1)
+	}
+
+	// Test case 2: Fractions
+	expected2 := "| 1 | 2 |\n| ---: | ---: |\n| 1 | 9/4 |\n| 3 | 2/3 |"
+	if result2 := RenderMarkdown(m, RenderOptions{Fractions: true}); result2 != expected2 {
+		t.Errorf("RenderMarkdown failed for fractions: expected\n%s\ngot\n%s", expected2, result2)
+	}
+}
+
+// TestRenderHTML tests the RenderHTML function
+func TestRenderHTML(t *testing.T) {
+	m := MustParse("[1 2 3; 4 5 0.1]")
+
+	// Test case 1: Augmented matrix
+	expected1 := "<table class=\"matrix\">\n" +
+		"<tr><td>1</td><td>2</td><td style=\"border-left: 1px solid\">3</td></tr>\n" +
+		"<tr><td>4</td><td>5</td><td style=\"border-left: 1px solid\">0.1</td></tr>\n" +
+		"</table>"
+	if result1 := RenderHTML(m, RenderOptions{Augment: 2}); result1 != expected1 {
+		t.Errorf("RenderHTML failed: expected\n%s\ngot\n%s", expected1, result1)
+	}
+}
+
+// TestFraction tests the fraction helper
+func TestFraction(t *testing.T) {
+	cases := []struct {
+		value    float64
+		maxDen   int
+		num, den int64
+		ok       bool
+	}{
+		{1.0 / 3, 100, 1, 3, true},
+		{-0.75, 100, -3, 4, true},
+		{5, 100, 5, 1, true},
+		{0, 100, 0, 1, true},
+		{1.0 / 101, 100, 0, 0, false},
+		{3.14159, 100, 0, 0, false},
+	}
+
+	for _, c := range cases {
+		num, den, ok := fraction(c.value, c.maxDen)
+		if ok != c.ok || (ok && (num != c.num || den != c.den)) {
+			t.Errorf("fraction(%v, %d) = %d/%d, %v; expected %d/%d, %v", c.value, c.maxDen, num, den, ok, c.num, c.den, c.ok)
+		}
+	}
+}
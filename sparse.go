@@ -0,0 +1,375 @@
+package matrix
+
+import (
+	"cmp"
+	"slices"
+)
+
+// COO is a sparse matrix in coordinate format: a list of (row, column, value) entries.
+// It is meant for assembly; duplicate entries are summed when it is converted to a dense matrix, CSR or CSC.
+type COO struct {
+	rows       int
+	columns    int
+	rowIndices []int
+	colIndices []int
+	data       []float64
+}
+
+// CSR is a sparse matrix in compressed sparse row format. The entries of row i are
+// colIndices[rowPtr[i]:rowPtr[i+1]] and data[rowPtr[i]:rowPtr[i+1]], sorted by column.
+type CSR struct {
+	rows       int
+	columns    int
+	rowPtr     []int
+	colIndices []int
+	data       []float64
+}
+
+// CSC is a sparse matrix in compressed sparse column format. The entries of column j are
+// rowIndices[colPtr[j]:colPtr[j+1]] and data[colPtr[j]:colPtr[j+1]], sorted by row.
+type CSC struct {
+	rows       int
+	columns    int
+	colPtr     []int
+	rowIndices []int
+	data       []float64
+}
+
+// NewCOO creates an empty rows × cols sparse matrix in coordinate format.
+func NewCOO(rows, cols int) *COO {
+	return &COO{
+		rows:    rows,
+		columns: cols,
+	}
+}
+
+// Append adds an entry to the matrix. Entries at the same position are summed on conversion.
+// Returns false if the position is out of bounds.
+func (c *COO) Append(i, j int, v float64) bool {
+	if i < 0 || i >= c.rows || j < 0 || j >= c.columns {
+		return false
+	}
+
+	c.rowIndices = append(c.rowIndices, i)
+	c.colIndices = append(c.colIndices, j)
+	c.data = append(c.data, v)
+	return true
+}
+
+// NNZ returns the number of stored entries, counting duplicates separately.
+func (c COO) NNZ() int {
+	return len(c.data)
+}
+
+// NNZ returns the number of stored entries.
+func (a CSR) NNZ() int {
+	return len(a.data)
+}
+
+// NNZ returns the number of stored entries.
+func (a CSC) NNZ() int {
+	return len(a.data)
+}
+
+// compress groups entries by their major index (the row for CSR, the column for CSC), sorts each group
+// by minor index and sums duplicates. It returns the pointer, index and data arrays of the compressed format.
+func compress(n int, major, minor []int, data []float64) ([]int, []int, []float64) {
+	// Count the entries of each major index and place them in their groups
+	ptr := make([]int, n+1)
+	for _, m := range major {
+		ptr[m+1]++
+	}
+	for k := 0; k < n; k++ {
+		ptr[k+1] += ptr[k]
+	}
+
+	type entry struct {
+		index int
+		value float64
+	}
+	entries := make([]entry, len(data))
+	next := slices.Clone(ptr[:n])
+	for k, m := range major {
+		entries[next[m]] = entry{minor[k], data[k]}
+		next[m]++
+	}
+
+	// Sort each group and sum duplicates
+	indices := make([]int, 0, len(data))
+	values := make([]float64, 0, len(data))
+	newPtr := make([]int, n+1)
+	for m := 0; m < n; m++ {
+		group := entries[ptr[m]:ptr[m+1]]
+		slices.SortStableFunc(group, func(a, b entry) int {
+			return cmp.Compare(a.index, b.index)
+		})
+		for k, e := range group {
+			if k > 0 && e.index == group[k-1].index {
+				values[len(values)-1] += e.value
+				continue
+			}
+			indices = append(indices, e.index)
+			values = append(values, e.value)
+		}
+		newPtr[m+1] = len(values)
+	}
+
+	return newPtr, indices, values
+}
+
+// expand returns the major index of every entry of a compressed format.
+// An empty pointer array, as in the zero value of CSR and CSC, has no entries.
+func expand(ptr []int) []int {
+	if len(ptr) == 0 {
+		return nil
+	}
+
+	major := make([]int, ptr[len(ptr)-1])
+	for m := 0; m+1 < len(ptr); m++ {
+		for k := ptr[m]; k < ptr[m+1]; k++ {
+			major[k] = m
+		}
+	}
+	return major
+}
+
+// COOToCSR converts a matrix in coordinate format to compressed sparse row format, summing duplicate entries.
+func COOToCSR(c COO) CSR {
+	ptr, indices, values := compress(c.rows, c.rowIndices, c.colIndices, c.data)
+	return CSR{rows: c.rows, columns: c.columns, rowPtr: ptr, colIndices: indices, data: values}
+}
+
+// COOToCSC converts a matrix in coordinate format to compressed sparse column format, summing duplicate entries.
+func COOToCSC(c COO) CSC {
+	ptr, indices, values := compress(c.columns, c.colIndices, c.rowIndices, c.data)
+	return CSC{rows: c.rows, columns: c.columns, colPtr: ptr, rowIndices: indices, data: values}
+}
+
+// CSRToCSC converts a matrix from compressed sparse row to compressed sparse column format.
+func CSRToCSC(a CSR) CSC {
+	ptr, indices, values := compress(a.columns, a.colIndices, expand(a.rowPtr), a.data)
+	return CSC{rows: a.rows, columns: a.columns, colPtr: ptr, rowIndices: indices, data: values}
+}
+
+// CSCToCSR converts a matrix from compressed sparse column to compressed sparse row format.
+func CSCToCSR(a CSC) CSR {
+	ptr, indices, values := compress(a.rows, a.rowIndices, expand(a.colPtr), a.data)
+	return CSR{rows: a.rows, columns: a.columns, rowPtr: ptr, colIndices: indices, data: values}
+}
+
+// COOFromMatrix converts a dense matrix to coordinate format, storing its non-zero elements in row-major order.
+func COOFromMatrix(m Matrix) COO {
+	result := COO{rows: m.rows, columns: m.columns}
+	for i := 0; i < m.rows; i++ {
+		for j := 0; j < m.columns; j++ {
+			if m.values[i][j] != 0 {
+				result.rowIndices = append(result.rowIndices, i)
+				result.colIndices = append(result.colIndices, j)
+				result.data = append(result.data, m.values[i][j])
+			}
+		}
+	}
+	return result
+}
+
+// CSRFromMatrix converts a dense matrix to compressed sparse row format, storing only its non-zero elements.
+func CSRFromMatrix(m Matrix) CSR {
+	result := CSR{rows: m.rows, columns: m.columns, rowPtr: make([]int, m.rows+1)}
+	for i := 0; i < m.rows; i++ {
+		for j := 0; j < m.columns; j++ {
+			if m.values[i][j] != 0 {
+				result.colIndices = append(result.colIndices, j)
+				result.data = append(result.data, m.values[i][j])
+			}
+		}
+		result.rowPtr[i+1] = len(result.data)
+	}
+	return result
+}
+
+// CSCFromMatrix converts a dense matrix to compressed sparse column format, storing only its non-zero elements.
+func CSCFromMatrix(m Matrix) CSC {
+	result := CSC{rows: m.rows, columns: m.columns, colPtr: make([]int, m.columns+1)}
+	for j := 0; j < m.columns; j++ {
+		for i := 0; i < m.rows; i++ {
+			if m.values[i][j] != 0 {
+				result.rowIndices = append(result.rowIndices, i)
+				result.data = append(result.data, m.values[i][j])
+			}
+		}
+		result.colPtr[j+1] = len(result.data)
+	}
+	return result
+}
+
+// COOToMatrix converts a matrix in coordinate format to a dense matrix, summing duplicate entries.
+func COOToMatrix(c COO) Matrix {
+	result := zeroMatrix(c.rows, c.columns)
+	for k, v := range c.data {
+		result.values[c.rowIndices[k]][c.colIndices[k]] += v
+	}
+	return result
+}
+
+// CSRToMatrix converts a matrix in compressed sparse row format to a dense matrix.
+func CSRToMatrix(a CSR) Matrix {
+	result := zeroMatrix(a.rows, a.columns)
+	for i := 0; i < a.rows; i++ {
+		for k := a.rowPtr[i]; k < a.rowPtr[i+1]; k++ {
+			result.values[i][a.colIndices[k]] = a.data[k]
+		}
+	}
+	return result
+}
+
+// CSCToMatrix converts a matrix in compressed sparse column format to a dense matrix.
+func CSCToMatrix(a CSC) Matrix {
+	result := zeroMatrix(a.rows, a.columns)
+	for j := 0; j < a.columns; j++ {
+		for k := a.colPtr[j]; k < a.colPtr[j+1]; k++ {
+			result.values[a.rowIndices[k]][j] = a.data[k]
+		}
+	}
+	return result
+}
+
+// TransposeCSR transposes a matrix in compressed sparse row format.
+func TransposeCSR(a CSR) CSR {
+	// The column format of a matrix has the same arrays as the row format of its transpose
+	csc := CSRToCSC(a)
+	return CSR{rows: a.columns, columns: a.rows, rowPtr: csc.colPtr, colIndices: csc.rowIndices, data: csc.data}
+}
+
+// TransposeCSC transposes a matrix in compressed sparse column format.
+func TransposeCSC(a CSC) CSC {
+	// The row format of a matrix has the same arrays as the column format of its transpose
+	csr := CSCToCSR(a)
+	return CSC{rows: a.columns, columns: a.rows, colPtr: csr.rowPtr, rowIndices: csr.colIndices, data: csr.data}
+}
+
+// MultiplyCSRVector multiplies a matrix in compressed sparse row format by a vector.
+// Returns nil if the length of the vector doesn't match the number of columns.
+func MultiplyCSRVector(a CSR, x []float64) []float64 {
+	if len(x) != a.columns {
+		return nil
+	}
+
+	result := make([]float64, a.rows)
+	for i := 0; i < a.rows; i++ {
+		for k := a.rowPtr[i]; k < a.rowPtr[i+1]; k++ {
+			result[i] += a.data[k] * x[a.colIndices[k]]
+		}
+	}
+	return result
+}
+
+// MultiplyCSCVector multiplies a matrix in compressed sparse column format by a vector.
+// Returns nil if the length of the vector doesn't match the number of columns.
+func MultiplyCSCVector(a CSC, x []float64) []float64 {
+	if len(x) != a.columns {
+		return nil
+	}
+
+	result := make([]float64, a.rows)
+	for j := 0; j < a.columns; j++ {
+		for k := a.colPtr[j]; k < a.colPtr[j+1]; k++ {
+			result[a.rowIndices[k]] += a.data[k] * x[j]
+		}
+	}
+	return result
+}
+
+// MultiplyCSRMatrix multiplies a matrix in compressed sparse row format by a dense matrix
+// and returns a pointer to the resulting dense matrix.
+// Returns nil if the number of columns of the sparse matrix does not equal the number of rows of the dense matrix.
+func MultiplyCSRMatrix(a CSR, b Matrix) *Matrix {
+	if a.columns != b.rows {
+		return nil
+	}
+
+	result := zeroMatrix(a.rows, b.columns)
+	for i := 0; i < a.rows; i++ {
+		for k := a.rowPtr[i]; k < a.rowPtr[i+1]; k++ {
+			v, row := a.data[k], b.values[a.colIndices[k]]
+			for j := 0; j < b.columns; j++ {
+				result.values[i][j] += v * row[j]
+			}
+		}
+	}
+	return &result
+}
+
+// MultiplyCSCMatrix multiplies a matrix in compressed sparse column format by a dense matrix
+// and returns a pointer to the resulting dense matrix.
+// Returns nil if the number of columns of the sparse matrix does not equal the number of rows of the dense matrix.
+func MultiplyCSCMatrix(a CSC, b Matrix) *Matrix {
+	if a.columns != b.rows {
+		return nil
+	}
+
+	result := zeroMatrix(a.rows, b.columns)
+	for p := 0; p < a.columns; p++ {
+		row := b.values[p]
+		for k := a.colPtr[p]; k < a.colPtr[p+1]; k++ {
+			v, target := a.data[k], result.values[a.rowIndices[k]]
+			for j := 0; j < b.columns; j++ {
+				target[j] += v * row[j]
+			}
+		}
+	}
+	return &result
+}
+
+// addCompressed adds two matrices in the same compressed format by merging their sorted groups.
+func addCompressed(ptrA, indA []int, dataA []float64, ptrB, indB []int, dataB []float64) ([]int, []int, []float64) {
+	n := len(ptrA) - 1
+	ptr := make([]int, n+1)
+	indices := make([]int, 0, len(dataA)+len(dataB))
+	values := make([]float64, 0, len(dataA)+len(dataB))
+
+	for m := 0; m < n; m++ {
+		p, q := ptrA[m], ptrB[m]
+		for p < ptrA[m+1] || q < ptrB[m+1] {
+			switch {
+			case q == ptrB[m+1] || (p < ptrA[m+1] && indA[p] < indB[q]):
+				indices = append(indices, indA[p])
+				values = append(values, dataA[p])
+				p++
+			case p == ptrA[m+1] || indB[q] < indA[p]:
+				indices = append(indices, indB[q])
+				values = append(values, dataB[q])
+				q++
+			default:
+				indices = append(indices, indA[p])
+				values = append(values, dataA[p]+dataB[q])
+				p++
+				q++
+			}
+		}
+		ptr[m+1] = len(values)
+	}
+
+	return ptr, indices, values
+}
+
+// AddCSR adds two matrices in compressed sparse row format and returns a pointer to the resulting matrix.
+// Returns nil if the matrices have different dimensions.
+func AddCSR(a, b CSR) *CSR {
+	if a.rows != b.rows || a.columns != b.columns {
+		return nil
+	}
+
+	ptr, indices, values := addCompressed(a.rowPtr, a.colIndices, a.data, b.rowPtr, b.colIndices, b.data)
+	return &CSR{rows: a.rows, columns: a.columns, rowPtr: ptr, colIndices: indices, data: values}
+}
+
+// AddCSC adds two matrices in compressed sparse column format and returns a pointer to the resulting matrix.
+// Returns nil if the matrices have different dimensions.
+func AddCSC(a, b CSC) *CSC {
+	if a.rows != b.rows || a.columns != b.columns {
+		return nil
+	}
+
+	ptr, indices, values := addCompressed(a.colPtr, a.rowIndices, a.data, b.colPtr, b.rowIndices, b.data)
+	return &CSC{rows: a.rows, columns: a.columns, colPtr: ptr, rowIndices: indices, data: values}
+}
@@ -0,0 +1,170 @@
+package matrix
+
+import (
+	"testing"
+)
+
+// TestCOOAssembly tests assembling a COO matrix and converting it to CSR and CSC
+func TestCOOAssembly(t *testing.T) {
+	// Test case 1: Duplicate entries are summed and entries are sorted
+	c := NewCOO(3, 3)
+	entries := []struct {
+		i, j int
+		v    float64
+	}{
+		{2, 2, 1}, {0, 1, 2}, {2, 0, 3}, {0, 1, 4}, {1, 1, 5}, {2, 2, -1},
+	}
+	for _, e := range entries {
+		if !c.Append(e.i, e.j, e.v) {
+			t.Fatalf("Append rejected the valid position (%d, %d)", e.i, e.j)
+		}
+	}
+	if c.NNZ() != 6 {
+		t.Errorf("COO should store every appended entry, got %d", c.NNZ())
+	}
+
+	expected := MustParse("[0 6 0; 0 5 0; 3 0 0]")
+
+	if result := COOToMatrix(*c); !matricesEqual(t, &expected, &result) {
+		t.Errorf("COOToMatrix failed to sum duplicates")
+	}
+
+	csr := COOToCSR(*c)
+	if result := CSRToMatrix(csr); !matricesEqual(t, &expected, &result) {
+		t.Errorf("COOToCSR failed to sum duplicates")
+	}
+	if csr.NNZ() != 4 {
+		t.Errorf("COOToCSR should merge duplicate positions, got %d entries", csr.NNZ())
+	}
+	if csr.colIndices[2] != 0 || csr.colIndices[3] != 2 {
+		t.Errorf("COOToCSR should sort entries by column within a row, got %v", csr.colIndices)
+	}
+
+	csc := COOToCSC(*c)
+	if result := CSCToMatrix(csc); !matricesEqual(t, &expected, &result) {
+		t.Errorf("COOToCSC failed to sum duplicates")
+	}
+
+	// Test case 2: Out of bounds entries are rejected
+	if c.Append(3, 0, 1) || c.Append(0, -1, 1) {
+		t.Errorf("Append should reject out of bounds positions")
+	}
+}
+
+// TestSparseConversion tests conversion between dense, CSR and CSC formats
+func TestSparseConversion(t *testing.T) {
+	m := MustParse("[1 0 2 0; 0 0 0 0; 0 3 0 4]")
+
+	coo := COOFromMatrix(m)
+	csr := CSRFromMatrix(m)
+	csc := CSCFromMatrix(m)
+	if coo.NNZ() != 4 || csr.NNZ() != 4 || csc.NNZ() != 4 {
+		t.Errorf("Sparse formats should store only non-zero elements, got %d, %d and %d", coo.NNZ(), csr.NNZ(), csc.NNZ())
+	}
+
+	conversions := []struct {
+		name   string
+		result Matrix
+	}{
+		{"COOToMatrix", COOToMatrix(coo)},
+		{"COOToCSR", CSRToMatrix(COOToCSR(coo))},
+		{"CSRToMatrix", CSRToMatrix(csr)},
+		{"CSCToMatrix", CSCToMatrix(csc)},
+		{"CSRToCSC", CSCToMatrix(CSRToCSC(csr))},
+		{"CSCToCSR", CSRToMatrix(CSCToCSR(csc))},
+	}
+	for _, c := range conversions {
+		if !matricesEqual(t, &m, &c.result) {
+			t.Errorf("%s did not reproduce the dense matrix", c.name)
+		}
+	}
+}
+
+// TestTransposeSparse tests TransposeCSR and TransposeCSC
+func TestTransposeSparse(t *testing.T) {
+	m := MustParse("[1 0 2; 0 3 0]")
+	expected := TransposeMatrix(m)
+
+	if result := CSRToMatrix(TransposeCSR(CSRFromMatrix(m))); !matricesEqual(t, expected, &result) {
+		t.Errorf("TransposeCSR failed")
+	}
+	if result := CSCToMatrix(TransposeCSC(CSCFromMatrix(m))); !matricesEqual(t, expected, &result) {
+		t.Errorf("TransposeCSC failed")
+	}
+}
+
+// TestSparseZeroValue tests that the zero values of the sparse formats behave as 0x0 matrices
+func TestSparseZeroValue(t *testing.T) {
+	empty := zeroMatrix(0, 0)
+	conversions := []struct {
+		name   string
+		result Matrix
+	}{
+		{"COOToMatrix", COOToMatrix(COO{})},
+		{"CSRToCSC", CSCToMatrix(CSRToCSC(CSR{}))},
+		{"CSCToCSR", CSRToMatrix(CSCToCSR(CSC{}))},
+		{"TransposeCSR", CSRToMatrix(TransposeCSR(CSR{}))},
+		{"TransposeCSC", CSCToMatrix(TransposeCSC(CSC{}))},
+	}
+	for _, c := range conversions {
+		if !matricesEqual(t, &empty, &c.result) {
+			t.Errorf("%s did not produce a 0x0 matrix from the zero value", c.name)
+		}
+	}
+}
+
+// TestMultiplySparse tests the sparse × vector and sparse × dense products
+func TestMultiplySparse(t *testing.T) {
+	a := MustParse("[1 0 2; 0 3 0; 4 0 5]")
+	b := MustParse("[1 2; 3 4; 5 6]")
+
+	// Test case 1: Sparse × dense matches the dense product
+	expected := MultiplyMatrices(a, b)
+	if !matricesEqual(t, expected, MultiplyCSRMatrix(CSRFromMatrix(a), b)) {
+		t.Errorf("MultiplyCSRMatrix does not match MultiplyMatrices")
+	}
+	if !matricesEqual(t, expected, MultiplyCSCMatrix(CSCFromMatrix(a), b)) {
+		t.Errorf("MultiplyCSCMatrix does not match MultiplyMatrices")
+	}
+
+	// Test case 2: Sparse × vector
+	x := []float64{1, -1, 2}
+	want := []float64{5, -3, 14}
+	for name, y := range map[string][]float64{
+		"MultiplyCSRVector": MultiplyCSRVector(CSRFromMatrix(a), x),
+		"MultiplyCSCVector": MultiplyCSCVector(CSCFromMatrix(a), x),
+	} {
+		for i := range want {
+			if y[i] != want[i] {
+				t.Errorf("%s failed: expected %v, got %v", name, want, y)
+				break
+			}
+		}
+	}
+
+	// Test case 3: Mismatched dimensions
+	if MultiplyCSRVector(CSRFromMatrix(a), []float64{1}) != nil || MultiplyCSCMatrix(CSCFromMatrix(b), b) != nil {
+		t.Errorf("Sparse products should return nil for mismatched dimensions")
+	}
+}
+
+// TestAddSparse tests AddCSR and AddCSC
+func TestAddSparse(t *testing.T) {
+	a := MustParse("[1 0 2; 0 0 3]")
+	b := MustParse("[0 4 -2; 5 0 0]")
+	expected := AddMatrices(a, b)
+
+	sumCSR := AddCSR(CSRFromMatrix(a), CSRFromMatrix(b))
+	if result := CSRToMatrix(*sumCSR); !matricesEqual(t, expected, &result) {
+		t.Errorf("AddCSR does not match AddMatrices")
+	}
+
+	sumCSC := AddCSC(CSCFromMatrix(a), CSCFromMatrix(b))
+	if result := CSCToMatrix(*sumCSC); !matricesEqual(t, expected, &result) {
+		t.Errorf("AddCSC does not match AddMatrices")
+	}
+
+	if AddCSR(CSRFromMatrix(a), CSRFromMatrix(*TransposeMatrix(a))) != nil {
+		t.Errorf("AddCSR should return nil for matrices with different dimensions")
+	}
+}
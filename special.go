@@ -0,0 +1,197 @@
+package matrix
+
+// Hilbert returns a pointer to the n × n Hilbert matrix, with elements 1/(i+j+1) for zero-based i and j.
+// Hilbert matrices are notoriously ill-conditioned, which makes them a good stress test for elimination.
+// Returns nil if n is negative.
+func Hilbert(n int) *Matrix {
+	// Check if the size is valid
+	if n < 0 {
+		return nil
+	}
+
+	result := zeroMatrix(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			result.values[i][j] = 1 / float64(i+j+1)
+		}
+	}
+
+	return &result
+}
+
+// Vandermonde returns a pointer to the len(x) × cols Vandermonde matrix of the points x, with elements x[i]^j
+// in increasing powers from left to right. Multiplying it by the coefficients of a polynomial, lowest degree first,
+// evaluates the polynomial at every point.
+// Returns nil if cols is negative.
+func Vandermonde(x []float64, cols int) *Matrix {
+	// Check if the number of columns is valid
+	if cols < 0 {
+		return nil
+	}
+
+	result := zeroMatrix(len(x), cols)
+	for i, xi := range x {
+		power := 1.0
+		for j := 0; j < cols; j++ {
+			result.values[i][j] = power
+			power *= xi
+		}
+	}
+
+	return &result
+}
+
+// Toeplitz returns a pointer to the len(c) × len(r) Toeplitz matrix with first column c and first row r,
+// in which every diagonal is constant. The diagonal is taken from c[0], so r[0] is ignored.
+// Returns nil if c or r is empty.
+func Toeplitz(c, r []float64) *Matrix {
+	// Check if the first column and row are non-empty
+	if len(c) == 0 || len(r) == 0 {
+		return nil
+	}
+
+	result := zeroMatrix(len(c), len(r))
+	for i := range c {
+		for j := range r {
+			if i >= j {
+				result.values[i][j] = c[i-j]
+			} else {
+				result.values[i][j] = r[j-i]
+			}
+		}
+	}
+
+	return &result
+}
+
+// Hankel returns a pointer to the len(c) × len(r) Hankel matrix with first column c and last row r,
+// in which every anti-diagonal is constant. The bottom-left element is taken from the last element of c, so r[0] is ignored.
+// Returns nil if c or r is empty.
+func Hankel(c, r []float64) *Matrix {
+	// Check if the first column and last row are non-empty
+	if len(c) == 0 || len(r) == 0 {
+		return nil
+	}
+
+	result := zeroMatrix(len(c), len(r))
+	for i := range c {
+		for j := range r {
+			if k := i + j; k < len(c) {
+				result.values[i][j] = c[k]
+			} else {
+				result.values[i][j] = r[k-len(c)+1]
+			}
+		}
+	}
+
+	return &result
+}
+
+// Circulant returns a pointer to the n × n circulant matrix with first column c, where n is len(c).
+// Each column is the previous column rotated down by one element.
+// Returns nil if c is empty.
+func Circulant(c []float64) *Matrix {
+	// Check if the first column is non-empty
+	if len(c) == 0 {
+		return nil
+	}
+
+	n := len(c)
+	result := zeroMatrix(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			result.values[i][j] = c[(i-j+n)%n]
+		}
+	}
+
+	return &result
+}
+
+// Companion returns a pointer to the companion matrix of the polynomial with the given coefficients, highest degree first,
+// so that coeffs {a0, a1, ..., an} describe a0·xⁿ + a1·xⁿ⁻¹ + ... + an. The first row holds -a1/a0, ..., -an/a0 and
+// the subdiagonal holds ones, so the eigenvalues of the n × n result are the roots of the polynomial.
+// Returns nil if there are fewer than two coefficients or the leading coefficient is zero.
+func Companion(coeffs []float64) *Matrix {
+	// Check if the polynomial has degree at least one
+	if len(coeffs) < 2 || coeffs[0] == 0 {
+		return nil
+	}
+
+	n := len(coeffs) - 1
+	result := zeroMatrix(n, n)
+	for j := 0; j < n; j++ {
+		result.values[0][j] = -coeffs[j+1] / coeffs[0]
+	}
+	for i := 1; i < n; i++ {
+		result.values[i][i-1] = 1
+	}
+
+	return &result
+}
+
+// Pascal returns a pointer to the n × n symmetric Pascal matrix, with elements the binomial coefficients C(i+j, i)
+// for zero-based i and j. It is positive definite with determinant one, so its inverse has integer elements.
+// Returns nil if n is negative.
+func Pascal(n int) *Matrix {
+	// Check if the size is valid
+	if n < 0 {
+		return nil
+	}
+
+	// Each element is the sum of the elements above and to the left of it
+	result := zeroMatrix(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if i == 0 || j == 0 {
+				result.values[i][j] = 1
+			} else {
+				result.values[i][j] = result.values[i-1][j] + result.values[i][j-1]
+			}
+		}
+	}
+
+	return &result
+}
+
+// Lehmer returns a pointer to the n × n Lehmer matrix, with elements min(i, j)/max(i, j) for one-based i and j.
+// It is symmetric and positive definite.
+// Returns nil if n is negative.
+func Lehmer(n int) *Matrix {
+	// Check if the size is valid
+	if n < 0 {
+		return nil
+	}
+
+	result := zeroMatrix(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			result.values[i][j] = float64(min(i, j)+1) / float64(max(i, j)+1)
+		}
+	}
+
+	return &result
+}
+
+// Tridiagonal returns a pointer to the n × n tridiagonal matrix with the given subdiagonal, main diagonal and superdiagonal,
+// where n is len(diag).
+// Returns nil if diag is empty or lower and upper don't both have one element fewer than diag.
+func Tridiagonal(lower, diag, upper []float64) *Matrix {
+	// Check if the diagonals have consistent lengths
+	n := len(diag)
+	if len(lower) != n-1 || len(upper) != n-1 {
+		return nil
+	}
+
+	result := zeroMatrix(n, n)
+	for i := 0; i < n; i++ {
+		result.values[i][i] = diag[i]
+		if i > 0 {
+			result.values[i][i-1] = lower[i-1]
+		}
+		if i < n-1 {
+			result.values[i][i+1] = upper[i]
+		}
+	}
+
+	return &result
+}
@@ -0,0 +1,166 @@
+package matrix
+
+import (
+	"math/big"
+	"testing"
+)
+
+// TestHilbert tests the Hilbert function
+func TestHilbert(t *testing.T) {
+	expected := NewMatrix(3, 3, [][]float64{
+		{1, 1.0 / 2, 1.0 / 3},
+		{1.0 / 2, 1.0 / 3, 1.0 / 4},
+		{1.0 / 3, 1.0 / 4, 1.0 / 5},
+	})
+	if !matricesEqual(t, &expected, Hilbert(3)) {
+		t.Errorf("Hilbert failed for n = 3")
+	}
+
+	// The ill-conditioning shows up in elimination: solving H·x = H·1 loses accuracy quickly as n grows
+	solveError := func(n int) float64 {
+		h := *Hilbert(n)
+		ones := zeroMatrix(n, 1)
+		for i := 0; i < n; i++ {
+			ones.values[i][0] = 1
+		}
+		rref := ReducedRowEchelonForm(*HStack(h, *MultiplyMatrices(h, ones)))
+		return maxAbsDifference(*Slice(*rref, 0, n, n, n+1), ones)
+	}
+	if e := solveError(4); e > 1e-9 {
+		t.Errorf("Solving the 4x4 Hilbert system should be accurate to 1e-9, got error %g", e)
+	}
+	if e := solveError(10); e < 1e-9 {
+		t.Errorf("Solving the 10x10 Hilbert system was expected to lose accuracy, got error %g", e)
+	}
+
+	if Hilbert(-1) != nil {
+		t.Errorf("Hilbert should return nil for a negative size")
+	}
+}
+
+// TestVandermonde tests the Vandermonde function
+func TestVandermonde(t *testing.T) {
+	expected := MustParse("[1 1 1 1; 1 2 4 8; 1 -3 9 -27]")
+	v := Vandermonde([]float64{1, 2, -3}, 4)
+	if !matricesEqual(t, &expected, v) {
+		t.Errorf("Vandermonde failed")
+	}
+
+	// Multiplying by coefficients evaluates the polynomial 1 - x + 2x³ at every point
+	values := MultiplyMatrices(*v, MustParse("[1; -1; 0; 2]"))
+	want := MustParse("[2; 15; -50]")
+	if !matricesEqual(t, &want, values) {
+		t.Errorf("Vandermonde did not evaluate the polynomial")
+	}
+
+	if Vandermonde([]float64{1}, -1) != nil {
+		t.Errorf("Vandermonde should return nil for a negative number of columns")
+	}
+}
+
+// TestToeplitzAndHankel tests the Toeplitz and Hankel functions
+func TestToeplitzAndHankel(t *testing.T) {
+	// Test case 1: Toeplitz with a different first row, whose first element is ignored
+	toeplitz := MustParse("[1 4 5 6; 2 1 4 5; 3 2 1 4]")
+	if !matricesEqual(t, &toeplitz, Toeplitz([]float64{1, 2, 3}, []float64{99, 4, 5, 6})) {
+		t.Errorf("Toeplitz failed")
+	}
+
+	// Test case 2: Hankel with a last row whose first element is ignored
+	hankel := MustParse("[1 2 3 4; 2 3 4 5; 3 4 5 6]")
+	if !matricesEqual(t, &hankel, Hankel([]float64{1, 2, 3}, []float64{99, 4, 5, 6})) {
+		t.Errorf("Hankel failed")
+	}
+
+	// Test case 3: Empty inputs
+	if Toeplitz(nil, []float64{1}) != nil || Hankel([]float64{1}, nil) != nil {
+		t.Errorf("Toeplitz and Hankel should return nil for an empty column or row")
+	}
+}
+
+// TestCirculant tests the Circulant function
+func TestCirculant(t *testing.T) {
+	expected := MustParse("[1 3 2; 2 1 3; 3 2 1]")
+	if !matricesEqual(t, &expected, Circulant([]float64{1, 2, 3})) {
+		t.Errorf("Circulant failed")
+	}
+	if Circulant(nil) != nil {
+		t.Errorf("Circulant should return nil for an empty column")
+	}
+}
+
+// TestCompanion tests the Companion function
+func TestCompanion(t *testing.T) {
+	// Test case 1: 2x³ - 12x² + 22x - 12 = 2(x - 1)(x - 2)(x - 3)
+	c := Companion([]float64{2, -12, 22, -12})
+	expected := MustParse("[6 -11 6; 1 0 0; 0 1 0]")
+	if !matricesEqual(t, &expected, c) {
+		t.Fatalf("Companion failed")
+	}
+
+	// Test case 2: Each root r is an eigenvalue with eigenvector (r², r, 1)
+	for _, r := range []float64{1, 2, 3} {
+		v := NewMatrix(3, 1, [][]float64{{r * r}, {r}, {1}})
+		if !Equal(*MultiplyMatrices(*c, v), *MultiplyColumn(v, 0, r)) {
+			t.Errorf("Companion: %v is not an eigenvalue", r)
+		}
+	}
+
+	// Test case 3: Invalid polynomials
+	if Companion([]float64{1}) != nil || Companion([]float64{0, 1, 2}) != nil {
+		t.Errorf("Companion should return nil for a constant polynomial or a zero leading coefficient")
+	}
+}
+
+// TestPascalAndLehmer tests the Pascal and Lehmer functions
+func TestPascalAndLehmer(t *testing.T) {
+	// Test case 1: Pascal values, determinant and integer inverse
+	pascal := Pascal(4)
+	expected := MustParse("[1 1 1 1; 1 2 3 4; 1 3 6 10; 1 4 10 20]")
+	if !matricesEqual(t, &expected, pascal) {
+		t.Fatalf("Pascal failed")
+	}
+	exact := RatMatrixFromMatrix(*pascal)
+	if det := RatDeterminant(*exact); det == nil || det.Cmp(big.NewRat(1, 1)) != 0 {
+		t.Errorf("Pascal should have determinant 1, got %v", det)
+	}
+	inverse := RatInverse(*exact)
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			if !inverse.At(i, j).IsInt() {
+				t.Errorf("The inverse of the Pascal matrix should have integer elements, got %v", inverse.At(i, j))
+			}
+		}
+	}
+
+	// Test case 2: Lehmer values and positive definiteness
+	lehmer := Lehmer(3)
+	expectedLehmer := NewMatrix(3, 3, [][]float64{
+		{1, 1.0 / 2, 1.0 / 3},
+		{1.0 / 2, 1, 2.0 / 3},
+		{1.0 / 3, 2.0 / 3, 1},
+	})
+	if !matricesEqual(t, &expectedLehmer, lehmer) {
+		t.Errorf("Lehmer failed")
+	}
+	if !IsPositiveDefinite(*Lehmer(8), 1e-12) || !IsPositiveDefinite(*Pascal(6), 1e-12) {
+		t.Errorf("Lehmer and Pascal matrices should be positive definite")
+	}
+
+	if Pascal(-1) != nil || Lehmer(-1) != nil {
+		t.Errorf("Pascal and Lehmer should return nil for a negative size")
+	}
+}
+
+// TestTridiagonal tests the Tridiagonal function
+func TestTridiagonal(t *testing.T) {
+	expected := MustParse("[2 -1 0 0; -1 2 -1 0; 0 -1 2 -1; 0 0 -1 2]")
+	result := Tridiagonal([]float64{-1, -1, -1}, []float64{2, 2, 2, 2}, []float64{-1, -1, -1})
+	if !matricesEqual(t, &expected, result) {
+		t.Errorf("Tridiagonal failed")
+	}
+
+	if Tridiagonal([]float64{1}, []float64{1, 2}, nil) != nil || Tridiagonal(nil, nil, nil) != nil {
+		t.Errorf("Tridiagonal should return nil for inconsistent or empty diagonals")
+	}
+}
@@ -0,0 +1,83 @@
+package matrix
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// RowOperationKind identifies one of the three elementary row operations.
+type RowOperationKind int
+
+const (
+	// RowSwap exchanges rows Target and Source, as SwapRows does.
+	RowSwap RowOperationKind = iota
+	// RowScale multiplies row Target by Scalar, as MultiplyRow does.
+	RowScale
+	// RowAddScaled adds Scalar times row Source to row Target, as AddScaledRow does.
+	RowAddScaled
+)
+
+// RowOperation is an elementary row operation. Row indices are 0-based.
+type RowOperation struct {
+	Kind   RowOperationKind
+	Target int
+	Source int
+	Scalar float64
+}
+
+// String describes the operation with 1-based row numbers, such as "R1 ↔ R3", "R2 ← 1/2·R2" or "R3 ← R3 − 4·R1".
+func (op RowOperation) String() string {
+	switch op.Kind {
+	case RowSwap:
+		return fmt.Sprintf("R%d ↔ R%d", op.Target+1, op.Source+1)
+	case RowScale:
+		return fmt.Sprintf("R%d ← %s·R%d", op.Target+1, formatScalar(op.Scalar), op.Target+1)
+	case RowAddScaled:
+		sign, scalar := "+", op.Scalar
+		if scalar < 0 {
+			sign, scalar = "−", -scalar
+		}
+		if scalar == 1 {
+			return fmt.Sprintf("R%d ← R%d %s R%d", op.Target+1, op.Target+1, sign, op.Source+1)
+		}
+		return fmt.Sprintf("R%d ← R%d %s %s·R%d", op.Target+1, op.Target+1, sign, formatScalar(scalar), op.Source+1)
+	}
+	return fmt.Sprintf("RowOperation(%d)", op.Kind)
+}
+
+// formatScalar formats a scalar as a simple fraction when it is close to one, otherwise in its shortest form.
+func formatScalar(v float64) string {
+	if num, den, ok := fraction(v, 100); ok {
+		if den == 1 {
+			return strconv.FormatInt(num, 10)
+		}
+		return plainFraction(num, den)
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// Step is one step of a traced elimination: the operation applied and the matrix after applying it.
+type Step struct {
+	Operation RowOperation
+	Matrix    Matrix
+}
+
+// RowEchelonFormTrace performs the same Gaussian elimination as RowEchelonForm and returns a pointer to the resulting matrix
+// together with every elementary row operation applied, in order, and a snapshot of the matrix after each one.
+func RowEchelonFormTrace(m Matrix) (*Matrix, []Step) {
+	var steps []Step
+	result := eliminate(m, false, func(op RowOperation, snapshot Matrix) {
+		steps = append(steps, Step{Operation: op, Matrix: snapshot})
+	})
+	return result, steps
+}
+
+// ReducedRowEchelonFormTrace performs the same Gauss-Jordan elimination as ReducedRowEchelonForm and returns a pointer to the resulting matrix
+// together with every elementary row operation applied, in order, and a snapshot of the matrix after each one.
+func ReducedRowEchelonFormTrace(m Matrix) (*Matrix, []Step) {
+	var steps []Step
+	result := eliminate(m, true, func(op RowOperation, snapshot Matrix) {
+		steps = append(steps, Step{Operation: op, Matrix: snapshot})
+	})
+	return result, steps
+}
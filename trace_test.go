@@ -0,0 +1,83 @@
+package matrix
+
+import (
+	"testing"
+)
+
+// TestRowOperationString tests the String method of RowOperation
+func TestRowOperationString(t *testing.T) {
+	cases := []struct {
+		op       RowOperation
+		expected string
+	}{
+		{RowOperation{Kind: RowSwap, Target: 0, Source: 2}, "R1 ↔ R3"},
+		{RowOperation{Kind: RowScale, Target: 1, Source: 1, Scalar: 0.5}, "R2 ← 1/2·R2"},
+		{RowOperation{Kind: RowScale, Target: 0, Source: 0, Scalar: 3.14159}, "R1 ← 3.14159·R1"},
+		{RowOperation{Kind: RowAddScaled, Target: 2, Source: 0, Scalar: -4}, "R3 ← R3 − 4·R1"},
+		{RowOperation{Kind: RowAddScaled, Target: 0, Source: 1, Scalar: 1}, "R1 ← R1 + R2"},
+	}
+
+	for _, c := range cases {
+		if result := c.op.String(); result != c.expected {
+			t.Errorf("RowOperation.String failed: expected %q, got %q", c.expected, result)
+		}
+	}
+}
+
+// TestRowEchelonFormTrace tests the RowEchelonFormTrace function
+func TestRowEchelonFormTrace(t *testing.T) {
+	// Test case 1: A swap followed by a scaling
+	m1 := MustParse("[0 1; 2 4]")
+
+	result1, steps1 := RowEchelonFormTrace(m1)
+	expected1 := []string{"R1 ↔ R2", "R1 ← 1/2·R1"}
+	if len(steps1) != len(expected1) {
+		t.Fatalf("RowEchelonFormTrace recorded %d steps, expected %d", len(steps1), len(expected1))
+	}
+	for k, step := range steps1 {
+		if step.Operation.String() != expected1[k] {
+			t.Errorf("RowEchelonFormTrace step %d: expected %q, got %q", k+1, expected1[k], step.Operation)
+		}
+	}
+
+	snapshot := MustParse("[2 4; 0 1]")
+	if !matricesEqual(t, &snapshot, &steps1[0].Matrix) {
+		t.Errorf("RowEchelonFormTrace recorded the wrong snapshot after the swap")
+	}
+	if !matricesEqual(t, RowEchelonForm(m1), result1) || !matricesEqual(t, result1, &steps1[1].Matrix) {
+		t.Errorf("RowEchelonFormTrace should end with the result of RowEchelonForm")
+	}
+
+	// Test case 2: A matrix already in row echelon form needs no steps
+	m2 := MustParse("[1 2; 0 1]")
+	if _, steps2 := RowEchelonFormTrace(m2); len(steps2) != 0 {
+		t.Errorf("RowEchelonFormTrace should record no steps for a matrix in row echelon form, got %v", steps2)
+	}
+}
+
+// TestReducedRowEchelonFormTrace tests the ReducedRowEchelonFormTrace function
+func TestReducedRowEchelonFormTrace(t *testing.T) {
+	// Test case 1: Elimination below and above the pivots
+	m1 := MustParse("[2 4 2; 1 3 4]")
+
+	result1, steps1 := ReducedRowEchelonFormTrace(m1)
+	expected1 := []string{"R1 ← 1/2·R1", "R2 ← R2 − R1", "R1 ← R1 − 2·R2"}
+	if len(steps1) != len(expected1) {
+		t.Fatalf("ReducedRowEchelonFormTrace recorded %d steps, expected %d", len(steps1), len(expected1))
+	}
+	for k, step := range steps1 {
+		if step.Operation.String() != expected1[k] {
+			t.Errorf("ReducedRowEchelonFormTrace step %d: expected %q, got %q", k+1, expected1[k], step.Operation)
+		}
+	}
+
+	expected := MustParse("[1 0 -5; 0 1 3]")
+	if !matricesEqual(t, &expected, result1) || !matricesEqual(t, ReducedRowEchelonForm(m1), result1) {
+		t.Errorf("ReducedRowEchelonFormTrace returned the wrong result")
+	}
+
+	// Test case 2: Snapshots are independent of each other
+	if steps1[0].Matrix.values[1][0] != 1 || steps1[1].Matrix.values[1][0] != 0 {
+		t.Errorf("ReducedRowEchelonFormTrace snapshots should reflect the matrix after each step")
+	}
+}